@@ -1,26 +1,61 @@
 package main
 
 import (
+	"context"
 	"log"
+	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
 	infraAuth "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/auth"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/imaging"
 	infraRepos "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/repositories"
+	infraStorage "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/storage"
+	infraStream "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/stream"
+	infraTimeline "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/timeline"
+	infraTrending "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/trending"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/handlers"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/middlewares"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/routes"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/auth"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/block"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/bookmark"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/follow"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/hashtag"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/like"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/media"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/mute"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/notification"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/post"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/stream"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/user"
 )
 
+const (
+	// Number of posts kept in each cached home timeline
+	timelineCacheSize = 800
+	// Accounts with at least this many followers are not fanned out on write
+	celebrityFollowerThreshold = 10000
+	// How long after posting the author may still edit a post
+	postEditWindow = 30 * time.Minute
+	// Local media storage directory, served at /uploads
+	localMediaDir = "uploads"
+	// Uploads still pending after mediaStaleAfter are re-enqueued, checked
+	// every mediaSweepInterval
+	mediaStaleAfter    = 10 * time.Minute
+	mediaSweepInterval = 5 * time.Minute
+	// Trending hashtags are ranked over this sliding window
+	trendingWindow     = time.Hour
+	trendingBucketSize = 5 * time.Minute
+	// How long a computed trending ranking is served before recomputing
+	trendingSnapshotTTL = 30 * time.Second
+)
+
 func main() {
 	// Database connection
 	dsn := "host=localhost user=user password=password dbname=x_clone port=5433 sslmode=disable"
@@ -31,39 +66,141 @@ func main() {
 
 	// Migration
 	// Auto Migrate
-	if err := db.AutoMigrate(&models.User{}, &models.Post{}, &models.Like{}, &models.Bookmark{}); err != nil {
+	if err := db.AutoMigrate(&models.User{}, &models.Post{}, &models.Like{}, &models.Bookmark{}, &models.Follow{}, &models.Notification{}, &models.PostMention{}, &models.Hashtag{}, &models.PostHashtag{}, &models.Media{}, &models.MediaVariant{}, &models.PostRevision{}, &models.Block{}, &models.Mute{}, &models.MutedWord{}, &models.FollowRequest{}); err != nil {
 		log.Fatal("Failed to migrate:", err)
 	}
+	// Backfills run first so existing rows satisfy the unique indexes
+	if err := infraRepos.BackfillConversationIDs(db); err != nil {
+		log.Fatal("Failed to backfill conversations:", err)
+	}
+	if err := infraRepos.BackfillPostKinds(db); err != nil {
+		log.Fatal("Failed to backfill post kinds:", err)
+	}
+	if err := infraRepos.DedupeReposts(db); err != nil {
+		log.Fatal("Failed to dedupe reposts:", err)
+	}
+	if err := infraRepos.DedupeNotifications(db); err != nil {
+		log.Fatal("Failed to dedupe notifications:", err)
+	}
+	if err := infraRepos.CreateIndexes(db); err != nil {
+		log.Fatal("Failed to create indexes:", err)
+	}
 
 	// Redis connection
 	sessionManager := infraAuth.NewSessionManager("localhost:6379", "", 0)
+	redisClient := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+
+	// Timeline cache
+	timelineCache := infraTimeline.NewTimelineCache(redisClient, timelineCacheSize)
+	fanoutQueue := infraTimeline.NewFanoutQueue(redisClient)
+
+	// Trending hashtags
+	hashtagTracker := infraTrending.NewHashtagTracker(redisClient, trendingWindow, trendingBucketSize, trendingSnapshotTTL)
+
+	// Media storage and processing
+	mediaStorage, err := infraStorage.NewFromEnv(localMediaDir, "http://localhost:8080/uploads")
+	if err != nil {
+		log.Fatal("Failed to prepare media storage:", err)
+	}
+	mediaProcessingQueue := imaging.NewProcessingQueue(redisClient)
+
+	// Real-time events
+	eventBroker := infraStream.NewEventBroker(redisClient)
 
 	// Repositories
 	userRepo := infraRepos.NewUserRepository(db)
 	postRepo := infraRepos.NewPostRepository(db)
 	likeRepo := infraRepos.NewLikeRepository(db)
 	bookmarkRepo := infraRepos.NewBookmarkRepository(db)
+	followRepo := infraRepos.NewFollowRepository(db)
+	followRequestRepo := infraRepos.NewFollowRequestRepository(db)
+	notificationRepo := infraRepos.NewNotificationRepository(db)
+	hashtagRepo := infraRepos.NewHashtagRepository(db)
+	mediaRepo := infraRepos.NewMediaRepository(db)
+	postRevisionRepo := infraRepos.NewPostRevisionRepository(db)
+	blockRepo := infraRepos.NewBlockRepository(db)
+	muteRepo := infraRepos.NewMuteRepository(db)
+	mutedWordRepo := infraRepos.NewMutedWordRepository(db)
+	txManager := infraRepos.NewTransactionManager(db)
 
 	// UseCases
 	createUserUC := user.NewCreateUserUseCase(userRepo)
-	getUserProfileUC := user.NewGetUserProfileUseCase(userRepo)
+	getUserProfileUC := user.NewGetUserProfileUseCase(userRepo, followRepo, followRequestRepo, postRepo)
 	getMeUC := user.NewGetMeUseCase(userRepo)
+	updateProfileUC := user.NewUpdateProfileUseCase(userRepo)
+	uploadProfileImageUC := user.NewUploadProfileImageUseCase(userRepo, mediaStorage)
 	loginUC := auth.NewLoginUseCase(userRepo, sessionManager)
-	createPostUC := post.NewCreatePostUseCase(postRepo, userRepo)
-	getTimelineUC := post.NewGetTimelineUseCase(postRepo, likeRepo, bookmarkRepo)
+	createPostUC := post.NewCreatePostUseCase(postRepo, userRepo, notificationRepo, hashtagRepo, mediaRepo, txManager, fanoutQueue, eventBroker, hashtagTracker)
+	getTimelineUC := post.NewGetTimelineUseCase(postRepo, likeRepo, bookmarkRepo, followRepo, mutedWordRepo, timelineCache, celebrityFollowerThreshold)
 	getBookmarksUC := post.NewGetBookmarksUseCase(postRepo, likeRepo, bookmarkRepo)
-	deletePostUC := post.NewDeletePostUseCase(postRepo)
+	deletePostUC := post.NewDeletePostUseCase(postRepo, txManager)
 	getPostDetailUC := post.NewGetPostDetailUseCase(postRepo, userRepo, likeRepo, bookmarkRepo)
-	getRepliesUC := post.NewGetRepliesUseCase(postRepo, userRepo, likeRepo, bookmarkRepo)
-	toggleLikeUC := like.NewToggleLikeUseCase(likeRepo)
-	toggleBookmarkUC := bookmark.NewToggleBookmarkUseCase(bookmarkRepo)
+	getRepliesUC := post.NewGetRepliesUseCase(postRepo, likeRepo, bookmarkRepo, followRepo, mutedWordRepo)
+	getMentionsUC := post.NewGetMentionsUseCase(postRepo, likeRepo, bookmarkRepo)
+	editPostUC := post.NewEditPostUseCase(postRepo, userRepo, likeRepo, bookmarkRepo, postRevisionRepo, notificationRepo, hashtagRepo, mediaRepo, txManager, postEditWindow)
+	getPostRevisionsUC := post.NewGetPostRevisionsUseCase(postRepo, postRevisionRepo)
+	getConversationUC := post.NewGetConversationUseCase(postRepo, likeRepo, bookmarkRepo, mutedWordRepo, followRepo)
+	getQuotesUC := post.NewGetQuotesUseCase(postRepo, likeRepo, bookmarkRepo)
+	undoRepostUC := post.NewUndoRepostUseCase(postRepo, txManager)
+	getHashtagPostsUC := post.NewGetHashtagPostsUseCase(postRepo, likeRepo, bookmarkRepo)
+	searchPostsUC := post.NewSearchPostsUseCase(postRepo, likeRepo, bookmarkRepo)
+	searchUsersUC := user.NewSearchUsersUseCase(userRepo)
+	autocompleteUsersUC := user.NewAutocompleteUsersUseCase(userRepo)
+	uploadMediaUC := media.NewUploadMediaUseCase(mediaRepo, mediaStorage, mediaProcessingQueue)
+	processMediaUC := media.NewProcessMediaUseCase(mediaRepo, txManager, mediaStorage)
+	requeueStaleMediaUC := media.NewRequeueStaleMediaUseCase(mediaRepo, mediaProcessingQueue, mediaStaleAfter)
+	getTrendingHashtagsUC := hashtag.NewGetTrendingHashtagsUseCase(hashtagTracker)
+	toggleLikeUC := like.NewToggleLikeUseCase(likeRepo, postRepo, notificationRepo, txManager, eventBroker)
+	getLikersUC := like.NewGetLikersUseCase(likeRepo, postRepo)
+	getLikedPostsUC := post.NewGetLikedPostsUseCase(postRepo, userRepo, followRepo, likeRepo, bookmarkRepo)
+	toggleBookmarkUC := bookmark.NewToggleBookmarkUseCase(bookmarkRepo, postRepo, txManager)
+	followUserUC := follow.NewFollowUserUseCase(followRepo, blockRepo, followRequestRepo, userRepo, notificationRepo, txManager, timelineCache)
+	unfollowUserUC := follow.NewUnfollowUserUseCase(followRepo, followRequestRepo, userRepo, txManager, timelineCache)
+	getFollowersUC := follow.NewGetFollowersUseCase(followRepo, userRepo)
+	getFollowingUC := follow.NewGetFollowingUseCase(followRepo, userRepo)
+	getFollowRequestsUC := follow.NewGetFollowRequestsUseCase(followRequestRepo)
+	approveFollowRequestUC := follow.NewApproveFollowRequestUseCase(followRepo, followRequestRepo, userRepo, txManager, timelineCache)
+	denyFollowRequestUC := follow.NewDenyFollowRequestUseCase(followRequestRepo, userRepo)
+	updatePrivacyUC := follow.NewUpdatePrivacyUseCase(followRepo, followRequestRepo, userRepo, txManager, timelineCache)
+	blockUserUC := block.NewBlockUserUseCase(blockRepo, followRepo, followRequestRepo, userRepo, txManager, timelineCache)
+	unblockUserUC := block.NewUnblockUserUseCase(blockRepo, userRepo)
+	getBlockedUsersUC := block.NewGetBlockedUsersUseCase(blockRepo)
+	muteUserUC := mute.NewMuteUserUseCase(muteRepo, userRepo)
+	unmuteUserUC := mute.NewUnmuteUserUseCase(muteRepo, userRepo)
+	getMutedUsersUC := mute.NewGetMutedUsersUseCase(muteRepo)
+	createMutedWordUC := mute.NewCreateMutedWordUseCase(mutedWordRepo)
+	getMutedWordsUC := mute.NewGetMutedWordsUseCase(mutedWordRepo)
+	updateMutedWordUC := mute.NewUpdateMutedWordUseCase(mutedWordRepo)
+	deleteMutedWordUC := mute.NewDeleteMutedWordUseCase(mutedWordRepo)
+	subscribeEventsUC := stream.NewSubscribeEventsUseCase(followRepo, eventBroker)
+	getNotificationsUC := notification.NewGetNotificationsUseCase(notificationRepo, mutedWordRepo, followRepo)
+	getUnreadCountUC := notification.NewGetUnreadCountUseCase(notificationRepo, mutedWordRepo, followRepo)
+	markNotificationsReadUC := notification.NewMarkNotificationsReadUseCase(notificationRepo)
+	fanOutPostUC := post.NewFanOutPostUseCase(followRepo, userRepo, timelineCache, celebrityFollowerThreshold)
+
+	// Workers
+	fanoutWorker := infraTimeline.NewFanoutWorker(fanoutQueue, fanOutPostUC.Execute)
+	go fanoutWorker.Run(context.Background())
+	mediaWorker := imaging.NewProcessingWorker(mediaProcessingQueue, processMediaUC.Execute)
+	go mediaWorker.Run(context.Background())
+	mediaSweeper := imaging.NewProcessingSweeper(mediaSweepInterval, requeueStaleMediaUC.Execute)
+	go mediaSweeper.Run(context.Background())
+	go eventBroker.Run(context.Background())
 
 	// Handlers
-	userHandler := handlers.NewUserHandler(createUserUC, getUserProfileUC, getMeUC)
+	userHandler := handlers.NewUserHandler(createUserUC, getUserProfileUC, getMeUC, updateProfileUC, uploadProfileImageUC)
 	authHandler := handlers.NewAuthHandler(loginUC)
-	postHandler := handlers.NewPostHandler(createPostUC, getTimelineUC, getBookmarksUC, deletePostUC, getPostDetailUC, getRepliesUC)
-	likeHandler := handlers.NewLikeHandler(toggleLikeUC)
+	postHandler := handlers.NewPostHandler(createPostUC, getTimelineUC, getBookmarksUC, deletePostUC, getPostDetailUC, getRepliesUC, getMentionsUC, editPostUC, getPostRevisionsUC, getConversationUC, getQuotesUC, undoRepostUC)
+	likeHandler := handlers.NewLikeHandler(toggleLikeUC, getLikersUC, getLikedPostsUC)
 	bookmarkHandler := handlers.NewBookmarkHandler(toggleBookmarkUC)
+	followHandler := handlers.NewFollowHandler(followUserUC, unfollowUserUC, getFollowersUC, getFollowingUC, getFollowRequestsUC, approveFollowRequestUC, denyFollowRequestUC, updatePrivacyUC)
+	blockHandler := handlers.NewBlockHandler(blockUserUC, unblockUserUC, getBlockedUsersUC)
+	muteHandler := handlers.NewMuteHandler(muteUserUC, unmuteUserUC, getMutedUsersUC, createMutedWordUC, getMutedWordsUC, updateMutedWordUC, deleteMutedWordUC)
+	streamHandler := handlers.NewStreamHandler(subscribeEventsUC)
+	hashtagHandler := handlers.NewHashtagHandler(getHashtagPostsUC, getTrendingHashtagsUC)
+	mediaHandler := handlers.NewMediaHandler(uploadMediaUC)
+	searchHandler := handlers.NewSearchHandler(searchPostsUC, searchUsersUC, autocompleteUsersUC)
+	notificationHandler := handlers.NewNotificationHandler(getNotificationsUC, getUnreadCountUC, markNotificationsReadUC)
 
 	// Middlewares
 	authMiddleware := middlewares.NewAuthMiddleware(sessionManager)
@@ -79,7 +216,11 @@ func main() {
 	config.AllowCredentials = true
 	router.Use(cors.New(config))
 
-	routes.SetupRoutes(router, userHandler, authHandler, postHandler, likeHandler, bookmarkHandler, authMiddleware)
+	if _, ok := mediaStorage.(*infraStorage.LocalStorage); ok {
+		router.Static("/uploads", localMediaDir)
+	}
+
+	routes.SetupRoutes(router, userHandler, authHandler, postHandler, likeHandler, bookmarkHandler, followHandler, blockHandler, muteHandler, streamHandler, notificationHandler, hashtagHandler, searchHandler, mediaHandler, authMiddleware)
 
 	// Start server
 	if err := router.Run(":8080"); err != nil {
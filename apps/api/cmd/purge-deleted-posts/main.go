@@ -0,0 +1,47 @@
+// Command purge-deleted-posts removes the content and media of posts that
+// were deleted more than -days ago. Tombstones still referenced by replies or
+// reposts are kept without content; the rest are removed entirely.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	infraRepos "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/repositories"
+	infraStorage "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/storage"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/post"
+)
+
+func main() {
+	days := flag.Int("days", 30, "purge posts deleted more than this many days ago")
+	flag.Parse()
+
+	// Database connection
+	dsn := "host=localhost user=user password=password dbname=x_clone port=5433 sslmode=disable"
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+
+	mediaStorage, err := infraStorage.NewFromEnv("uploads", "http://localhost:8080/uploads")
+	if err != nil {
+		log.Fatal("Failed to prepare media storage:", err)
+	}
+
+	postRepo := infraRepos.NewPostRepository(db)
+	mediaRepo := infraRepos.NewMediaRepository(db)
+	txManager := infraRepos.NewTransactionManager(db)
+	purgeUC := post.NewPurgeDeletedPostsUseCase(postRepo, mediaRepo, txManager, mediaStorage)
+
+	output, err := purgeUC.Execute(context.Background(), time.Duration(*days)*24*time.Hour)
+	if err != nil {
+		log.Fatal("Failed to purge deleted posts:", err)
+	}
+
+	log.Printf("Purged %d deleted posts, removed %d unreferenced tombstones", output.Purged, output.Removed)
+}
@@ -0,0 +1,35 @@
+// Command reconcile-counters recomputes the like, bookmark, reply and repost
+// counters stored on posts and the follow counters stored on users from the
+// underlying tables.
+package main
+
+import (
+	"context"
+	"log"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	infraRepos "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/repositories"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/post"
+)
+
+func main() {
+	// Database connection
+	dsn := "host=localhost user=user password=password dbname=x_clone port=5433 sslmode=disable"
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+
+	postRepo := infraRepos.NewPostRepository(db)
+	userRepo := infraRepos.NewUserRepository(db)
+	reconcileUC := post.NewReconcileCountersUseCase(postRepo, userRepo)
+
+	output, err := reconcileUC.Execute(context.Background())
+	if err != nil {
+		log.Fatal("Failed to reconcile counters:", err)
+	}
+
+	log.Printf("Reconciled counters on %d posts and %d users", output.PostsFixed, output.UsersFixed)
+}
@@ -3,9 +3,21 @@ package errors
 import "errors"
 
 var (
-	ErrUserNotFound       = errors.New("user not found")
-	ErrUserAlreadyExists  = errors.New("user already exists")
-	ErrInvalidInput       = errors.New("invalid input")
-	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrUnauthorized       = errors.New("unauthorized")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrUserAlreadyExists     = errors.New("user already exists")
+	ErrInvalidInput          = errors.New("invalid input")
+	ErrInvalidCredentials    = errors.New("invalid credentials")
+	ErrUnauthorized          = errors.New("unauthorized")
+	ErrInvalidCursor         = errors.New("invalid cursor")
+	ErrMediaTooLarge         = errors.New("media too large")
+	ErrUnsupportedMedia      = errors.New("unsupported media type")
+	ErrPostNotFound          = errors.New("post not found")
+	ErrEditWindowExpired     = errors.New("edit window has expired")
+	ErrAlreadyReposted       = errors.New("post already reposted")
+	ErrBlocked               = errors.New("blocked")
+	ErrMutedWordNotFound     = errors.New("muted word not found")
+	ErrMutedWordExists       = errors.New("word already muted")
+	ErrFollowRequestNotFound = errors.New("follow request not found")
+	ErrProtectedPost         = errors.New("post is protected")
+	ErrReplyNotAllowed       = errors.New("replies to this post are limited")
 )
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// Block hides BlockerID and BlockedID from each other. The blocked user can no
+// longer see, reply to, like, bookmark or repost the blocker's posts.
+type Block struct {
+	BlockerID uint      `gorm:"primaryKey" json:"blocker_id"`
+	BlockedID uint      `gorm:"primaryKey;index" json:"blocked_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,17 @@
+package models
+
+// Conversation is a post in the context of its thread: the chain of posts it
+// replies to, root first, and a tree of the replies below it. Deleted posts
+// appear as tombstones so the thread stays connected.
+type Conversation struct {
+	Ancestors []*Post
+	Post      *Post
+	Replies   []*ConversationNode
+}
+
+// ConversationNode is a reply and the replies below it, ranked among their
+// siblings.
+type ConversationNode struct {
+	Post    *Post
+	Replies []*ConversationNode
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+)
+
+// Cursor marks a position in a list ordered by (CreatedAt, ID). Clients only
+// ever see it as the opaque token produced by Encode.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        uint
+}
+
+// Encode returns the opaque token for the cursor.
+func (c Cursor) Encode() string {
+	raw := fmt.Sprintf("%d:%d", c.CreatedAt.UnixNano(), c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor parses a token produced by Cursor.Encode.
+func DecodeCursor(token string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, domainErrors.ErrInvalidCursor
+	}
+
+	var nanos int64
+	var id uint
+	if _, err := fmt.Sscanf(string(raw), "%d:%d", &nanos, &id); err != nil {
+		return nil, domainErrors.ErrInvalidCursor
+	}
+
+	return &Cursor{
+		CreatedAt: time.Unix(0, nanos).UTC(),
+		ID:        id,
+	}, nil
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// FollowRequest is a pending request from RequesterID to follow TargetID, a
+// private account. Approving it turns it into a Follow.
+type FollowRequest struct {
+	RequesterID uint      `gorm:"primaryKey" json:"requester_id"`
+	TargetID    uint      `gorm:"primaryKey;index" json:"target_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// Hashtag is a normalized tag (lowercase, without the '#').
+type Hashtag struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Name      string    `gorm:"uniqueIndex;not null" json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PostHashtag links a post to a tag in its content. CreatedAt copies the
+// post's creation time so tag timelines can page on this table alone.
+type PostHashtag struct {
+	PostID    uint      `gorm:"primaryKey" json:"post_id"`
+	HashtagID uint      `gorm:"primaryKey;index:idx_post_hashtags_tag_time,priority:1" json:"hashtag_id"`
+	CreatedAt time.Time `gorm:"index:idx_post_hashtags_tag_time,priority:2" json:"created_at"`
+	Post      Post      `gorm:"foreignKey:PostID;constraint:OnDelete:CASCADE" json:"-"`
+	Hashtag   Hashtag   `gorm:"foreignKey:HashtagID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TrendingHashtag is a tag ranked by recent usage. Score weighs recent uses
+// more heavily than older ones within the trending window.
+type TrendingHashtag struct {
+	Name  string
+	Score float64
+}
@@ -3,7 +3,7 @@ package models
 import "time"
 
 type Like struct {
-	UserID    uint      `gorm:"primaryKey" json:"user_id"`
-	PostID    uint      `gorm:"primaryKey" json:"post_id"`
-	CreatedAt time.Time `json:"created_at"`
+	UserID    uint      `gorm:"primaryKey;index:idx_likes_user_created,priority:1" json:"user_id"`
+	PostID    uint      `gorm:"primaryKey;index:idx_likes_post_created,priority:1" json:"post_id"`
+	CreatedAt time.Time `gorm:"index:idx_likes_user_created,priority:2;index:idx_likes_post_created,priority:2" json:"created_at"`
 }
@@ -0,0 +1,53 @@
+package models
+
+import "time"
+
+// Media limits
+const (
+	MaxMediaSize    = 5 << 20 // bytes
+	MaxMediaPerPost = 4
+)
+
+// Media processing states
+const (
+	MediaStatusPending = "pending"
+	MediaStatusReady   = "ready"
+	MediaStatusFailed  = "failed"
+)
+
+// Media is an uploaded image. It belongs to its uploader until a post claims
+// it, after which PostID and Position (its order within the post) are set.
+// Uploads start out pending; the column defaults to ready so rows that
+// predate processing are not mistaken for unprocessed uploads.
+type Media struct {
+	ID         uint           `gorm:"primaryKey" json:"id"`
+	OwnerID    uint           `gorm:"not null;index" json:"owner_id"`
+	PostID     *uint          `gorm:"index" json:"post_id"`
+	Position   int            `gorm:"not null;default:0" json:"position"`
+	StorageKey string         `gorm:"not null" json:"-"`
+	URL        string         `gorm:"not null" json:"url"`
+	MimeType   string         `gorm:"not null" json:"mime_type"`
+	Size       int64          `gorm:"not null" json:"size"`
+	Width      int            `gorm:"not null" json:"width"`
+	Height     int            `gorm:"not null" json:"height"`
+	Status     string         `gorm:"not null;default:ready" json:"status"`
+	BlurHash   string         `json:"blurhash"`
+	Variants   []MediaVariant `gorm:"foreignKey:MediaID;constraint:OnDelete:CASCADE" json:"variants"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+// TableName keeps the table name as "media"; the default would be "medias".
+func (Media) TableName() string {
+	return "media"
+}
+
+// MediaVariant is a downscaled copy of a Media image.
+type MediaVariant struct {
+	ID         uint   `gorm:"primaryKey" json:"id"`
+	MediaID    uint   `gorm:"not null;index" json:"media_id"`
+	Width      int    `gorm:"not null" json:"width"`
+	Height     int    `gorm:"not null" json:"height"`
+	StorageKey string `gorm:"not null" json:"-"`
+	URL        string `gorm:"not null" json:"url"`
+	MimeType   string `gorm:"not null" json:"mime_type"`
+}
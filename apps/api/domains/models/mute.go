@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// Mute hides MutedID from MuterID's timeline, replies and notifications
+// without MutedID knowing.
+type Mute struct {
+	MuterID   uint      `gorm:"primaryKey" json:"muter_id"`
+	MutedID   uint      `gorm:"primaryKey" json:"muted_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
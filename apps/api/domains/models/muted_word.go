@@ -0,0 +1,81 @@
+package models
+
+import (
+	"time"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/text"
+)
+
+// MutedWord hides posts containing Word, a normalized word, phrase or
+// #hashtag, from UserID's timelines, replies and notifications. With
+// NonFollowedOnly set it only applies to accounts UserID does not follow. A
+// nil ExpiresAt mutes indefinitely.
+type MutedWord struct {
+	ID              uint       `gorm:"primaryKey" json:"id"`
+	UserID          uint       `gorm:"not null;uniqueIndex:idx_muted_words_user_word" json:"user_id"`
+	Word            string     `gorm:"not null;uniqueIndex:idx_muted_words_user_word" json:"word"`
+	NonFollowedOnly bool       `gorm:"not null;default:false" json:"non_followed_only"`
+	ExpiresAt       *time.Time `json:"expires_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// MutedWordFilter decides which posts a user's active muted words hide from
+// them. The user's own posts are never hidden.
+type MutedWordFilter struct {
+	viewerID  uint
+	words     []*MutedWord
+	followees map[uint]bool
+}
+
+// NewMutedWordFilter builds a filter for viewerID. followeeIDs only matters
+// for words with NonFollowedOnly set.
+func NewMutedWordFilter(viewerID uint, words []*MutedWord, followeeIDs []uint) *MutedWordFilter {
+	followees := make(map[uint]bool, len(followeeIDs))
+	for _, id := range followeeIDs {
+		followees[id] = true
+	}
+	return &MutedWordFilter{viewerID: viewerID, words: words, followees: followees}
+}
+
+// Empty reports whether the filter has no words, so it hides nothing.
+func (f *MutedWordFilter) Empty() bool {
+	return len(f.words) == 0
+}
+
+// Hides reports whether post, or the post it reposts or quotes, contains a
+// muted word.
+func (f *MutedWordFilter) Hides(post *Post) bool {
+	if f.matches(post) {
+		return true
+	}
+	return post.Repost != nil && f.matches(post.Repost)
+}
+
+func (f *MutedWordFilter) matches(post *Post) bool {
+	if post.AuthorID == f.viewerID {
+		return false
+	}
+	for _, w := range f.words {
+		if w.NonFollowedOnly && f.followees[post.AuthorID] {
+			continue
+		}
+		if text.ContainsMutedWord(post.Content, w.Word) {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply returns the posts the filter does not hide, in order.
+func (f *MutedWordFilter) Apply(posts []*Post) []*Post {
+	if f.Empty() {
+		return posts
+	}
+	kept := posts[:0]
+	for _, p := range posts {
+		if !f.Hides(p) {
+			kept = append(kept, p)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// Notification types
+const (
+	NotificationTypeLike          = "like"
+	NotificationTypeReply         = "reply"
+	NotificationTypeRepost        = "repost"
+	NotificationTypeQuote         = "quote"
+	NotificationTypeFollow        = "follow"
+	NotificationTypeMention       = "mention"
+	NotificationTypeFollowRequest = "follow_request"
+)
+
+// MutableNotificationTypes are the notifications that point at someone
+// else's post, so the recipient's muted words can hide them. Likes and
+// reposts point at the recipient's own posts, and follows at none.
+var MutableNotificationTypes = []string{NotificationTypeReply, NotificationTypeQuote, NotificationTypeMention}
+
+// Notification tells RecipientID that ActorID did something. PostID is the
+// liked or reposted post, the reply or quote, or the post with the mention,
+// and is nil for follows and follow requests.
+type Notification struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	RecipientID uint       `gorm:"not null;index" json:"recipient_id"`
+	ActorID     uint       `gorm:"not null" json:"actor_id"`
+	Actor       User       `gorm:"foreignKey:ActorID;constraint:OnDelete:CASCADE" json:"actor"`
+	Type        string     `gorm:"not null" json:"type"`
+	PostID      *uint      `json:"post_id"`
+	Post        *Post      `gorm:"foreignKey:PostID;constraint:OnDelete:CASCADE" json:"post,omitempty"`
+	ReadAt      *time.Time `json:"read_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// NotificationGroup collapses notifications of the same type about the same
+// post, e.g. "A and 3 others liked your post". Actors holds the most recent
+// actors only; ActorCount is the total.
+type NotificationGroup struct {
+	Type       string
+	PostID     *uint
+	Post       *Post
+	Actors     []User
+	ActorCount int64
+	LatestAt   time.Time
+	IsRead     bool
+}
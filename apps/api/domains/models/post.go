@@ -2,25 +2,79 @@ package models
 
 import (
 	"time"
+
+	"gorm.io/gorm"
+)
+
+// Post kinds. A repost embeds another post without adding anything; a quote
+// embeds it alongside its own content or media.
+const (
+	PostKindOriginal = "original"
+	PostKindReply    = "reply"
+	PostKindRepost   = "repost"
+	PostKindQuote    = "quote"
+)
+
+// Reply policies limit who may reply to a post. The author, and anyone the
+// post mentions, can always reply.
+const (
+	ReplyPolicyEveryone  = "everyone"
+	ReplyPolicyFollowing = "following"
+	ReplyPolicyMentioned = "mentioned"
 )
 
+// ValidReplyPolicy reports whether policy is one of the reply policies.
+func ValidReplyPolicy(policy string) bool {
+	switch policy {
+	case ReplyPolicyEveryone, ReplyPolicyFollowing, ReplyPolicyMentioned:
+		return true
+	}
+	return false
+}
+
 type Post struct {
-	ID        uint      `gorm:"primaryKey" json:"id"`
-	Content   string    `json:"content"`
-	AuthorID  uint      `gorm:"not null" json:"author_id"`
-	Author    User      `gorm:"foreignKey:AuthorID" json:"author"`
-	ParentID  *uint     `json:"parent_id"`
-	Parent    *Post     `gorm:"foreignKey:ParentID" json:"parent,omitempty"`
-	Replies   []Post    `gorm:"foreignKey:ParentID" json:"replies,omitempty"`
-	RepostID  *uint     `json:"repost_id"`
-	Repost    *Post     `gorm:"foreignKey:RepostID" json:"repost,omitempty"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
-	IsLiked       bool      `gorm:"-" json:"is_liked"`
-	LikeCount     int64     `gorm:"-" json:"like_count"`
-	IsBookmarked  bool      `gorm:"-" json:"is_bookmarked"`
-	BookmarkCount int64     `gorm:"-" json:"bookmark_count"`
-	ReplyCount    int64     `gorm:"-" json:"reply_count"`
-	RepostCount   int64     `gorm:"-" json:"repost_count"`
-	IsReposted    bool      `gorm:"-" json:"is_reposted"`
+	ID             uint           `gorm:"primaryKey" json:"id"`
+	Content        string         `json:"content"`
+	Kind           string         `gorm:"not null;default:original;index" json:"kind"`
+	ReplyPolicy    string         `gorm:"not null;default:everyone" json:"reply_policy"`
+	AuthorID       uint           `gorm:"not null" json:"author_id"`
+	Author         User           `gorm:"foreignKey:AuthorID" json:"author"`
+	ParentID       *uint          `json:"parent_id"`
+	Parent         *Post          `gorm:"foreignKey:ParentID" json:"parent,omitempty"`
+	Replies        []Post         `gorm:"foreignKey:ParentID" json:"replies,omitempty"`
+	RepostID       *uint          `json:"repost_id"`
+	Repost         *Post          `gorm:"foreignKey:RepostID" json:"repost,omitempty"`
+	Mentions       []PostMention  `gorm:"foreignKey:PostID;constraint:OnDelete:CASCADE" json:"mentions"`
+	Media          []Media        `gorm:"foreignKey:PostID;constraint:OnDelete:CASCADE" json:"media"`
+	LikeCount      int64          `gorm:"not null;default:0" json:"like_count"`
+	BookmarkCount  int64          `gorm:"not null;default:0" json:"bookmark_count"`
+	ReplyCount     int64          `gorm:"not null;default:0" json:"reply_count"`
+	RepostCount    int64          `gorm:"not null;default:0" json:"repost_count"`
+	QuoteCount     int64          `gorm:"not null;default:0" json:"quote_count"`
+	ConversationID uint           `gorm:"not null;default:0;index" json:"conversation_id"`
+	RevisionCount  int64          `gorm:"not null;default:0" json:"revision_count"`
+	EditedAt       *time.Time     `json:"edited_at"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	DeletedAt      gorm.DeletedAt `gorm:"index" json:"-"`
+	PurgedAt       *time.Time     `json:"-"`
+	IsLiked        bool           `gorm:"-" json:"is_liked"`
+	IsBookmarked   bool           `gorm:"-" json:"is_bookmarked"`
+	IsReposted     bool           `gorm:"-" json:"is_reposted"`
+	CanReply       bool           `gorm:"-" json:"can_reply"`
+}
+
+// PostKindOf classifies a new post by what it points at and whether it
+// carries content or media of its own.
+func PostKindOf(parentID, repostID *uint, hasBody bool) string {
+	switch {
+	case parentID != nil:
+		return PostKindReply
+	case repostID != nil && hasBody:
+		return PostKindQuote
+	case repostID != nil:
+		return PostKindRepost
+	default:
+		return PostKindOriginal
+	}
 }
@@ -0,0 +1,12 @@
+package models
+
+// PostMention is a resolved @username in a post's content. Start and End are
+// offsets in Unicode code points (End exclusive) and include the leading '@'.
+type PostMention struct {
+	ID     uint `gorm:"primaryKey" json:"id"`
+	PostID uint `gorm:"not null;index" json:"post_id"`
+	UserID uint `gorm:"not null;index" json:"user_id"`
+	User   User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-"`
+	Start  int  `gorm:"not null" json:"start"`
+	End    int  `gorm:"not null" json:"end"`
+}
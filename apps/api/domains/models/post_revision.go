@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// PostRevision is a superseded version of a post's content. CreatedAt is when
+// that version was written: the post's creation time for the first revision,
+// otherwise the time of the edit that produced it.
+type PostRevision struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	PostID    uint      `gorm:"not null;index" json:"post_id"`
+	Post      Post      `gorm:"foreignKey:PostID;constraint:OnDelete:CASCADE" json:"-"`
+	Content   string    `gorm:"not null" json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,107 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+)
+
+const searchDateLayout = "2006-01-02"
+
+// PostSearchQuery is a parsed post search. Text keeps the free-text part,
+// including "quoted phrases", in websearch syntax. Since is inclusive and
+// Until is exclusive.
+type PostSearchQuery struct {
+	Text         string
+	FromUsername string
+	Since        *time.Time
+	Until        *time.Time
+	HasReplies   bool
+}
+
+// ParsePostSearchQuery splits q into free text and the supported operators:
+// from:username, since:YYYY-MM-DD, until:YYYY-MM-DD (inclusive) and
+// has:replies. Tokens with any other prefix are treated as text.
+func ParsePostSearchQuery(q string) (*PostSearchQuery, error) {
+	query := &PostSearchQuery{}
+	var terms []string
+
+	for _, token := range tokenizeSearchQuery(q) {
+		name, value, ok := strings.Cut(token, ":")
+		if !ok || strings.HasPrefix(token, `"`) {
+			terms = append(terms, token)
+			continue
+		}
+
+		switch strings.ToLower(name) {
+		case "from":
+			query.FromUsername = strings.TrimPrefix(value, "@")
+		case "since":
+			t, err := time.Parse(searchDateLayout, value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: since must be YYYY-MM-DD", domainErrors.ErrInvalidInput)
+			}
+			query.Since = &t
+		case "until":
+			t, err := time.Parse(searchDateLayout, value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: until must be YYYY-MM-DD", domainErrors.ErrInvalidInput)
+			}
+			// Include the whole day
+			t = t.AddDate(0, 0, 1)
+			query.Until = &t
+		case "has":
+			if strings.ToLower(value) != "replies" {
+				return nil, fmt.Errorf("%w: unsupported has: filter %q", domainErrors.ErrInvalidInput, value)
+			}
+			query.HasReplies = true
+		default:
+			terms = append(terms, token)
+		}
+	}
+
+	query.Text = strings.Join(terms, " ")
+	if query.Text == "" && query.FromUsername == "" && query.Since == nil && query.Until == nil && !query.HasReplies {
+		return nil, fmt.Errorf("%w: empty search query", domainErrors.ErrInvalidInput)
+	}
+	return query, nil
+}
+
+// tokenizeSearchQuery splits q on whitespace, keeping "quoted phrases"
+// (with their quotes) as single tokens. An unterminated quote runs to the
+// end of the input.
+func tokenizeSearchQuery(q string) []string {
+	var tokens []string
+	var current strings.Builder
+	inQuotes := false
+
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, r := range q {
+		switch {
+		case r == '"':
+			current.WriteRune(r)
+			if inQuotes {
+				flush()
+			}
+			inQuotes = !inQuotes
+		case unicode.IsSpace(r) && !inQuotes:
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if inQuotes {
+		current.WriteRune('"')
+	}
+	flush()
+	return tokens
+}
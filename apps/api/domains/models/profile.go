@@ -0,0 +1,154 @@
+package models
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+)
+
+// Profile field limits, in code points
+const (
+	MaxDisplayNameLength = 50
+	MaxBioLength         = 160
+	MaxLocationLength    = 30
+	MaxWebsiteLength     = 100
+)
+
+// Birthday visibilities decide who sees a user's birthday besides the user
+const (
+	BirthdayVisibilityPublic    = "public"
+	BirthdayVisibilityFollowers = "followers"
+	BirthdayVisibilityPrivate   = "private"
+)
+
+// ProfileImage selects one of a user's profile images
+type ProfileImage string
+
+const (
+	ProfileImageAvatar ProfileImage = "avatar"
+	ProfileImageBanner ProfileImage = "banner"
+)
+
+// ProfileUpdate is a partial edit of a user's profile. Nil fields are left
+// as they are and empty strings clear a field. Birthday is YYYY-MM-DD.
+type ProfileUpdate struct {
+	DisplayName        *string
+	Bio                *string
+	Location           *string
+	Website            *string
+	Birthday           *string
+	BirthdayVisibility *string
+}
+
+// ApplyTo validates the update and applies it to user. Nothing is applied if
+// any field is invalid. Errors wrap ErrInvalidInput and name the field.
+func (p ProfileUpdate) ApplyTo(user *User, now time.Time) error {
+	updated := *user
+
+	if p.DisplayName != nil {
+		name := strings.TrimSpace(*p.DisplayName)
+		if utf8.RuneCountInString(name) > MaxDisplayNameLength {
+			return fmt.Errorf("%w: display name must be at most %d characters", domainErrors.ErrInvalidInput, MaxDisplayNameLength)
+		}
+		if strings.IndexFunc(name, unicode.IsControl) >= 0 {
+			return fmt.Errorf("%w: display name cannot contain control characters", domainErrors.ErrInvalidInput)
+		}
+		updated.DisplayName = name
+	}
+	if p.Bio != nil {
+		bio := strings.TrimSpace(*p.Bio)
+		if utf8.RuneCountInString(bio) > MaxBioLength {
+			return fmt.Errorf("%w: bio must be at most %d characters", domainErrors.ErrInvalidInput, MaxBioLength)
+		}
+		updated.Bio = bio
+	}
+	if p.Location != nil {
+		location := strings.TrimSpace(*p.Location)
+		if utf8.RuneCountInString(location) > MaxLocationLength {
+			return fmt.Errorf("%w: location must be at most %d characters", domainErrors.ErrInvalidInput, MaxLocationLength)
+		}
+		updated.Location = location
+	}
+	if p.Website != nil {
+		website, err := normalizeWebsite(*p.Website)
+		if err != nil {
+			return err
+		}
+		updated.Website = website
+	}
+	if p.Birthday != nil {
+		birthday, err := parseBirthday(*p.Birthday, now)
+		if err != nil {
+			return err
+		}
+		updated.Birthday = birthday
+	}
+	if p.BirthdayVisibility != nil {
+		switch *p.BirthdayVisibility {
+		case BirthdayVisibilityPublic, BirthdayVisibilityFollowers, BirthdayVisibilityPrivate:
+			updated.BirthdayVisibility = *p.BirthdayVisibility
+		default:
+			return fmt.Errorf("%w: birthday visibility must be public, followers or private", domainErrors.ErrInvalidInput)
+		}
+	}
+
+	*user = updated
+	return nil
+}
+
+// normalizeWebsite accepts an http or https URL, assuming https when the
+// scheme is left out.
+func normalizeWebsite(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", nil
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" || u.User != nil {
+		return "", fmt.Errorf("%w: website must be an http or https URL", domainErrors.ErrInvalidInput)
+	}
+	website := u.String()
+	if utf8.RuneCountInString(website) > MaxWebsiteLength {
+		return "", fmt.Errorf("%w: website must be at most %d characters", domainErrors.ErrInvalidInput, MaxWebsiteLength)
+	}
+	return website, nil
+}
+
+// parseBirthday parses a YYYY-MM-DD date that lies in the past.
+func parseBirthday(raw string, now time.Time) (*time.Time, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	birthday, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: birthday must be YYYY-MM-DD", domainErrors.ErrInvalidInput)
+	}
+	if !birthday.Before(now) || birthday.Year() < 1900 {
+		return nil, fmt.Errorf("%w: birthday is out of range", domainErrors.ErrInvalidInput)
+	}
+	return &birthday, nil
+}
+
+// BirthdayVisibleTo reports whether a viewer sees user's birthday, given
+// whether they follow user.
+func (u *User) BirthdayVisibleTo(viewerID uint, follows bool) bool {
+	if u.Birthday == nil {
+		return false
+	}
+	switch {
+	case viewerID == u.ID, u.BirthdayVisibility == BirthdayVisibilityPublic:
+		return true
+	case u.BirthdayVisibility == BirthdayVisibilityFollowers:
+		return follows
+	}
+	return false
+}
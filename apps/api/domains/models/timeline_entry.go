@@ -0,0 +1,9 @@
+package models
+
+import "time"
+
+// TimelineEntry is the reference to a post kept in a cached home timeline.
+type TimelineEntry struct {
+	PostID    uint
+	CreatedAt time.Time
+}
@@ -7,13 +7,25 @@ import (
 )
 
 type User struct {
-	ID        uint      `gorm:"primaryKey" json:"id"`
-	Username  string    `gorm:"unique;not null" json:"username"`
-	Email     string    `gorm:"unique;not null" json:"email"`
-	Password  string    `gorm:"not null" json:"-"`
-	Bio       string    `json:"bio"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID                 uint       `gorm:"primaryKey" json:"id"`
+	Username           string     `gorm:"unique;not null" json:"username"`
+	Email              string     `gorm:"unique;not null" json:"email"`
+	Password           string     `gorm:"not null" json:"-"`
+	DisplayName        string     `gorm:"not null;default:''" json:"display_name"`
+	Bio                string     `json:"bio"`
+	Location           string     `gorm:"not null;default:''" json:"location"`
+	Website            string     `gorm:"not null;default:''" json:"website"`
+	Birthday           *time.Time `gorm:"type:date" json:"-"`
+	BirthdayVisibility string     `gorm:"not null;default:private" json:"-"`
+	AvatarKey          string     `gorm:"not null;default:''" json:"-"`
+	AvatarURL          string     `gorm:"not null;default:''" json:"avatar_url"`
+	BannerKey          string     `gorm:"not null;default:''" json:"-"`
+	BannerURL          string     `gorm:"not null;default:''" json:"banner_url"`
+	IsPrivate          bool       `gorm:"not null;default:false" json:"is_private"`
+	FollowerCount      int64      `gorm:"not null;default:0" json:"follower_count"`
+	FollowingCount     int64      `gorm:"not null;default:0" json:"following_count"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
 }
 
 // HashPassword hashes the user's password
@@ -0,0 +1,77 @@
+package text
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxHashtagLength caps a tag in code points; longer runs are not tags.
+const maxHashtagLength = 100
+
+var hashtagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_#&])#([\p{L}\p{N}\p{M}_]+)`)
+
+// Hashtag is a #tag token in post content. Tag is normalized; Start and End
+// are offsets in Unicode code points, with End exclusive, and cover the '#'.
+type Hashtag struct {
+	Tag   string
+	Start int
+	End   int
+}
+
+// ExtractHashtags returns the #tag tokens in content in order of appearance.
+// Tokens made only of digits and underscores, like "#1", are not tags.
+func ExtractHashtags(content string) []Hashtag {
+	var hashtags []Hashtag
+	for _, m := range hashtagPattern.FindAllStringSubmatchIndex(content, -1) {
+		raw := content[m[2]:m[3]]
+		tag, ok := NormalizeHashtag(raw)
+		if !ok {
+			continue
+		}
+		start := utf8.RuneCountInString(content[:m[2]-1])
+		hashtags = append(hashtags, Hashtag{
+			Tag:   tag,
+			Start: start,
+			End:   start + 1 + utf8.RuneCountInString(raw),
+		})
+	}
+	return hashtags
+}
+
+// NormalizeHashtag lowercases tag and strips a leading '#'. It reports false
+// if the result is not a valid tag.
+func NormalizeHashtag(tag string) (string, bool) {
+	tag = strings.ToLower(strings.TrimPrefix(tag, "#"))
+	if tag == "" || utf8.RuneCountInString(tag) > maxHashtagLength {
+		return "", false
+	}
+
+	hasLetter := false
+	for _, r := range tag {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsNumber(r), unicode.IsMark(r), r == '_':
+		default:
+			return "", false
+		}
+	}
+	return tag, hasLetter
+}
+
+// UniqueTags returns the distinct tags in hashtags, keeping the order of
+// first appearance.
+func UniqueTags(hashtags []Hashtag) []string {
+	seen := make(map[string]bool, len(hashtags))
+	var tags []string
+	for _, h := range hashtags {
+		if seen[h.Tag] {
+			continue
+		}
+		seen[h.Tag] = true
+		tags = append(tags, h.Tag)
+	}
+	return tags
+}
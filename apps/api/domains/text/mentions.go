@@ -0,0 +1,50 @@
+// Package text extracts entities such as @mentions from post content.
+package text
+
+import (
+	"regexp"
+	"unicode/utf8"
+)
+
+var mentionPattern = regexp.MustCompile(`(?:^|[^A-Za-z0-9_@])@([A-Za-z0-9_]{1,50})`)
+
+// Mention is an @username token in post content. Start and End are offsets
+// in Unicode code points, with End exclusive, and cover the leading '@'.
+type Mention struct {
+	Username string
+	Start    int
+	End      int
+}
+
+// ExtractMentions returns the @username tokens in content in order of
+// appearance. Repeated usernames are returned each time they appear.
+func ExtractMentions(content string) []Mention {
+	var mentions []Mention
+	for _, m := range mentionPattern.FindAllStringSubmatchIndex(content, -1) {
+		// m[2]:m[3] is the username; the '@' sits right before it
+		atByte := m[2] - 1
+		start := utf8.RuneCountInString(content[:atByte])
+		username := content[m[2]:m[3]]
+		mentions = append(mentions, Mention{
+			Username: username,
+			Start:    start,
+			End:      start + 1 + utf8.RuneCountInString(username),
+		})
+	}
+	return mentions
+}
+
+// UniqueUsernames returns the distinct usernames in mentions, keeping the
+// order of first appearance.
+func UniqueUsernames(mentions []Mention) []string {
+	seen := make(map[string]bool, len(mentions))
+	var usernames []string
+	for _, m := range mentions {
+		if seen[m.Username] {
+			continue
+		}
+		seen[m.Username] = true
+		usernames = append(usernames, m.Username)
+	}
+	return usernames
+}
@@ -0,0 +1,78 @@
+package text
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxMutedWordLength caps a muted word or phrase in code points.
+const MaxMutedWordLength = 100
+
+// NormalizeMutedWord lowercases word and collapses its whitespace. It reports
+// false if nothing is left or the result is too long.
+func NormalizeMutedWord(word string) (string, bool) {
+	word = strings.ToLower(strings.Join(strings.Fields(word), " "))
+	if word == "" || utf8.RuneCountInString(word) > MaxMutedWordLength {
+		return "", false
+	}
+	return word, true
+}
+
+// ContainsMutedWord reports whether content contains word, as normalized by
+// NormalizeMutedWord, as a whole word or phrase. Whitespace in content is
+// collapsed first, and a muted "word" also matches "#word". A muted "#word"
+// only matches the hashtag.
+func ContainsMutedWord(content, word string) bool {
+	content = strings.ToLower(strings.Join(strings.Fields(content), " "))
+	for offset := 0; ; {
+		i := strings.Index(content[offset:], word)
+		if i < 0 {
+			return false
+		}
+		start := offset + i
+		end := start + len(word)
+		if isWordBoundary(content, start, end) {
+			return true
+		}
+		_, size := utf8.DecodeRuneInString(content[start:])
+		offset = start + size
+	}
+}
+
+// isWordBoundary reports whether content[start:end] is not glued to letters
+// or digits on either side. Scripts written without spaces between words
+// have no such boundaries, so an edge touching one of them always counts.
+func isWordBoundary(content string, start, end int) bool {
+	if start > 0 {
+		outer, _ := utf8.DecodeLastRuneInString(content[:start])
+		inner, _ := utf8.DecodeRuneInString(content[start:end])
+		if isGlued(inner, outer) {
+			return false
+		}
+	}
+	if end < len(content) {
+		outer, _ := utf8.DecodeRuneInString(content[end:])
+		inner, _ := utf8.DecodeLastRuneInString(content[start:end])
+		if isGlued(inner, outer) {
+			return false
+		}
+	}
+	return true
+}
+
+// isGlued reports whether outer, next to a match edge ending in inner, makes
+// the match part of a longer word.
+func isGlued(inner, outer rune) bool {
+	return isWordRune(outer) && !isUnspacedScript(inner) && !isUnspacedScript(outer)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsMark(r) || r == '_'
+}
+
+// isUnspacedScript reports whether r belongs to a script that does not
+// separate words with spaces.
+func isUnspacedScript(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul, unicode.Thai)
+}
@@ -0,0 +1,67 @@
+package text
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeMutedWord(t *testing.T) {
+	tests := []struct {
+		name   string
+		word   string
+		want   string
+		wantOK bool
+	}{
+		{name: "lowercases", word: "Spoiler", want: "spoiler", wantOK: true},
+		{name: "collapses whitespace", word: "  season\t finale \n", want: "season finale", wantOK: true},
+		{name: "keeps hashtag", word: "#GoTS8", want: "#gots8", wantOK: true},
+		{name: "blank", word: " \t ", wantOK: false},
+		{name: "at the limit", word: strings.Repeat("é", MaxMutedWordLength), want: strings.Repeat("é", MaxMutedWordLength), wantOK: true},
+		{name: "too long", word: strings.Repeat("é", MaxMutedWordLength+1), wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NormalizeMutedWord(tt.word)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("NormalizeMutedWord(%q) = %q, %v; want %q, %v", tt.word, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestContainsMutedWord(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		word    string
+		want    bool
+	}{
+		{name: "whole word", content: "no spoiler please", word: "spoiler", want: true},
+		{name: "case insensitive", content: "SPOILER alert", word: "spoiler", want: true},
+		{name: "inside a longer word", content: "spoilers everywhere", word: "spoiler", want: false},
+		{name: "glued to digits", content: "cat5 cable", word: "cat", want: false},
+		{name: "glued to underscore", content: "my_cat", word: "cat", want: false},
+		{name: "next to punctuation", content: "(spoiler!)", word: "spoiler", want: true},
+		{name: "later match after a partial one", content: "spoilers and a spoiler", word: "spoiler", want: true},
+		{name: "phrase across collapsed whitespace", content: "the season \n  finale", word: "season finale", want: true},
+		{name: "word matches hashtag", content: "watching #finale tonight", word: "finale", want: true},
+		{name: "hashtag matches hashtag", content: "watching #finale tonight", word: "#finale", want: true},
+		{name: "hashtag does not match bare word", content: "watching the finale", word: "#finale", want: false},
+		{name: "katakana inside japanese text", content: "今日はネタバレ注意", word: "ネタバレ", want: true},
+		{name: "kanji between kana", content: "映画の結末を知った", word: "結末", want: true},
+		{name: "latin next to kana", content: "finaleを見た", word: "finale", want: true},
+		{name: "non-latin word on its own", content: "ネタバレ 注意", word: "ネタバレ", want: true},
+		{name: "combining mark after match", content: "café time", word: "cafe", want: false},
+		{name: "accented word", content: "un café noir", word: "café", want: true},
+		{name: "absent", content: "nothing to see", word: "spoiler", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContainsMutedWord(tt.content, tt.word); got != tt.want {
+				t.Errorf("ContainsMutedWord(%q, %q) = %v; want %v", tt.content, tt.word, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package imaging
+
+import (
+	"image"
+	"math"
+	"strings"
+)
+
+const base83Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+// blurhashSampleWidth is the width images are shrunk to before encoding;
+// the hash only keeps a handful of low-frequency components anyway.
+const blurhashSampleWidth = 32
+
+// BlurHash encodes img as a BlurHash string (https://blurha.sh) with
+// xComponents by yComponents components, each between 1 and 9.
+func BlurHash(img image.Image, xComponents, yComponents int) string {
+	small := toNRGBA(Resize(img, blurhashSampleWidth))
+	w, h := small.Rect.Dx(), small.Rect.Dy()
+
+	// Convert to linear RGB once
+	linear := make([][3]float64, w*h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			p := small.Pix[y*small.Stride+x*4:]
+			linear[y*w+x] = [3]float64{srgbToLinear(p[0]), srgbToLinear(p[1]), srgbToLinear(p[2])}
+		}
+	}
+
+	factors := make([][3]float64, 0, xComponents*yComponents)
+	for j := 0; j < yComponents; j++ {
+		for i := 0; i < xComponents; i++ {
+			normalisation := 2.0
+			if i == 0 && j == 0 {
+				normalisation = 1.0
+			}
+			var f [3]float64
+			for y := 0; y < h; y++ {
+				for x := 0; x < w; x++ {
+					basis := normalisation *
+						math.Cos(math.Pi*float64(i)*float64(x)/float64(w)) *
+						math.Cos(math.Pi*float64(j)*float64(y)/float64(h))
+					px := linear[y*w+x]
+					f[0] += basis * px[0]
+					f[1] += basis * px[1]
+					f[2] += basis * px[2]
+				}
+			}
+			scale := 1.0 / float64(w*h)
+			factors = append(factors, [3]float64{f[0] * scale, f[1] * scale, f[2] * scale})
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(encode83((xComponents-1)+(yComponents-1)*9, 1))
+
+	dc, ac := factors[0], factors[1:]
+	maxValue := 1.0
+	if len(ac) > 0 {
+		actualMax := 0.0
+		for _, f := range ac {
+			actualMax = math.Max(actualMax, math.Max(math.Abs(f[0]), math.Max(math.Abs(f[1]), math.Abs(f[2]))))
+		}
+		quantisedMax := int(math.Max(0, math.Min(82, math.Floor(actualMax*166-0.5))))
+		maxValue = float64(quantisedMax+1) / 166
+		sb.WriteString(encode83(quantisedMax, 1))
+	} else {
+		sb.WriteString(encode83(0, 1))
+	}
+
+	sb.WriteString(encode83(encodeDC(dc), 4))
+	for _, f := range ac {
+		sb.WriteString(encode83(encodeAC(f, maxValue), 2))
+	}
+	return sb.String()
+}
+
+func encodeDC(c [3]float64) int {
+	return int(linearToSRGB(c[0]))<<16 | int(linearToSRGB(c[1]))<<8 | int(linearToSRGB(c[2]))
+}
+
+func encodeAC(c [3]float64, maxValue float64) int {
+	quant := func(v float64) int {
+		return int(math.Max(0, math.Min(18, math.Floor(signPow(v/maxValue, 0.5)*9+9.5))))
+	}
+	return quant(c[0])*19*19 + quant(c[1])*19 + quant(c[2])
+}
+
+func encode83(value, length int) string {
+	out := make([]byte, length)
+	for i := 1; i <= length; i++ {
+		digit := (value / int(math.Pow(83, float64(length-i)))) % 83
+		out[i-1] = base83Chars[digit]
+	}
+	return string(out)
+}
+
+func srgbToLinear(v uint8) float64 {
+	c := float64(v) / 255
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(v float64) uint8 {
+	c := math.Max(0, math.Min(1, v))
+	if c <= 0.0031308 {
+		return uint8(c*12.92*255 + 0.5)
+	}
+	return uint8((1.055*math.Pow(c, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(v, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(v), exp), v)
+}
@@ -0,0 +1,33 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+)
+
+// Decode decodes a stored image. For GIFs this is the first frame.
+func Decode(data []byte) (image.Image, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	return img, nil
+}
+
+// EncodeVariant encodes a resized variant as JPEG, or as PNG when the image
+// has transparency JPEG cannot hold. It returns the data and its MIME type.
+func EncodeVariant(img image.Image) ([]byte, string, error) {
+	var buf bytes.Buffer
+	if HasAlpha(img) {
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), "image/png", nil
+	}
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), "image/jpeg", nil
+}
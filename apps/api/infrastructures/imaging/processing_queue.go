@@ -0,0 +1,54 @@
+package imaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const processingQueueKey = "media:processing:queue"
+
+// ProcessingJob asks the worker to build variants for an uploaded image.
+type ProcessingJob struct {
+	MediaID uint `json:"media_id"`
+}
+
+// ProcessingQueue is a Redis list of pending image processing jobs shared by
+// every API instance.
+type ProcessingQueue struct {
+	client *redis.Client
+}
+
+func NewProcessingQueue(client *redis.Client) *ProcessingQueue {
+	return &ProcessingQueue{client: client}
+}
+
+func (q *ProcessingQueue) Enqueue(ctx context.Context, job ProcessingJob) error {
+	payload, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return q.client.LPush(ctx, processingQueueKey, payload).Err()
+}
+
+// Dequeue blocks for up to timeout waiting for the next job. It returns nil
+// without an error when the timeout elapses.
+func (q *ProcessingQueue) Dequeue(ctx context.Context, timeout time.Duration) (*ProcessingJob, error) {
+	result, err := q.client.BRPop(ctx, timeout, processingQueueKey).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// BRPOP returns the key followed by the value
+	var job ProcessingJob
+	if err := json.Unmarshal([]byte(result[1]), &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
@@ -0,0 +1,43 @@
+package imaging
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// ProcessingSweeper calls sweep every interval to re-enqueue media whose
+// processing job was lost.
+type ProcessingSweeper struct {
+	interval time.Duration
+	sweep    func(ctx context.Context) (int, error)
+}
+
+func NewProcessingSweeper(interval time.Duration, sweep func(ctx context.Context) (int, error)) *ProcessingSweeper {
+	return &ProcessingSweeper{
+		interval: interval,
+		sweep:    sweep,
+	}
+}
+
+// Run sweeps until ctx is cancelled.
+func (s *ProcessingSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		n, err := s.sweep(ctx)
+		if err != nil {
+			log.Printf("media: sweep failed: %v", err)
+		}
+		if n > 0 {
+			log.Printf("media: re-enqueued %d stale uploads", n)
+		}
+	}
+}
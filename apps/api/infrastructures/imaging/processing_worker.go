@@ -0,0 +1,43 @@
+package imaging
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// ProcessingWorker pulls jobs off the processing queue and hands them to
+// handle.
+type ProcessingWorker struct {
+	queue  *ProcessingQueue
+	handle func(ctx context.Context, job ProcessingJob) error
+}
+
+func NewProcessingWorker(queue *ProcessingQueue, handle func(ctx context.Context, job ProcessingJob) error) *ProcessingWorker {
+	return &ProcessingWorker{
+		queue:  queue,
+		handle: handle,
+	}
+}
+
+// Run processes jobs until ctx is cancelled.
+func (w *ProcessingWorker) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		job, err := w.queue.Dequeue(ctx, 5*time.Second)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("media: dequeue failed: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+		if job == nil {
+			continue
+		}
+
+		if err := w.handle(ctx, *job); err != nil {
+			log.Printf("media: processing media %d failed: %v", job.MediaID, err)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package imaging
+
+import (
+	"image"
+)
+
+// Resize scales img down to width pixels wide, keeping its aspect ratio, by
+// averaging the source pixels each destination pixel covers. Images already
+// narrower than width are returned unchanged.
+func Resize(img image.Image, width int) image.Image {
+	b := img.Bounds()
+	if width <= 0 || width >= b.Dx() {
+		return img
+	}
+	height := b.Dy() * width / b.Dx()
+	if height < 1 {
+		height = 1
+	}
+
+	src := toNRGBA(img)
+	// Resample horizontally, then vertically
+	tmp := resampleRows(src, width)
+	return resampleCols(tmp, height)
+}
+
+// span is the run of source indices one destination index covers, with the
+// share each contributes.
+type span struct {
+	start   int
+	weights []float64
+}
+
+func boxSpans(srcLen, dstLen int) []span {
+	scale := float64(srcLen) / float64(dstLen)
+	spans := make([]span, dstLen)
+	for i := range spans {
+		lo := float64(i) * scale
+		hi := lo + scale
+		start := int(lo)
+		end := int(hi)
+		if float64(end) < hi {
+			end++
+		}
+		if end > srcLen {
+			end = srcLen
+		}
+
+		ws := make([]float64, end-start)
+		for j := start; j < end; j++ {
+			l, r := float64(j), float64(j+1)
+			if l < lo {
+				l = lo
+			}
+			if r > hi {
+				r = hi
+			}
+			ws[j-start] = (r - l) / scale
+		}
+		spans[i] = span{start: start, weights: ws}
+	}
+	return spans
+}
+
+func resampleRows(src *image.NRGBA, width int) *image.NRGBA {
+	h := src.Rect.Dy()
+	dst := image.NewNRGBA(image.Rect(0, 0, width, h))
+	spans := boxSpans(src.Rect.Dx(), width)
+	for y := 0; y < h; y++ {
+		row := src.Pix[y*src.Stride:]
+		for x, sp := range spans {
+			blend(dst.Pix[y*dst.Stride+x*4:], row, sp, 4)
+		}
+	}
+	return dst
+}
+
+func resampleCols(src *image.NRGBA, height int) *image.NRGBA {
+	w := src.Rect.Dx()
+	dst := image.NewNRGBA(image.Rect(0, 0, w, height))
+	spans := boxSpans(src.Rect.Dy(), height)
+	for x := 0; x < w; x++ {
+		col := src.Pix[x*4:]
+		for y, sp := range spans {
+			blend(dst.Pix[y*dst.Stride+x*4:], col, sp, src.Stride)
+		}
+	}
+	return dst
+}
+
+// blend writes the weighted average of the pixels described by sp into out.
+// Colour channels are weighted by alpha so transparent pixels don't bleed.
+func blend(out, line []byte, sp span, step int) {
+	var r, g, b, a float64
+	for i, w := range sp.weights {
+		p := line[(sp.start+i)*step:]
+		pa := float64(p[3]) * w
+		r += float64(p[0]) * pa
+		g += float64(p[1]) * pa
+		b += float64(p[2]) * pa
+		a += pa
+	}
+	if a == 0 {
+		out[0], out[1], out[2], out[3] = 0, 0, 0, 0
+		return
+	}
+	out[0] = clamp8(r / a)
+	out[1] = clamp8(g / a)
+	out[2] = clamp8(b / a)
+	out[3] = clamp8(a)
+}
+
+func clamp8(v float64) uint8 {
+	switch {
+	case v <= 0:
+		return 0
+	case v >= 255:
+		return 255
+	}
+	return uint8(v + 0.5)
+}
+
+// HasAlpha reports whether any pixel of img is not fully opaque.
+func HasAlpha(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return !o.Opaque()
+	}
+	return true
+}
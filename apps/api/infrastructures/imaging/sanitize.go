@@ -0,0 +1,143 @@
+// Package imaging validates and re-encodes uploaded images.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+)
+
+// maxPixels rejects images whose decoded size would be unreasonable, which
+// guards against decompression bombs hiding behind a small upload. For
+// animated GIFs it bounds the pixels of all frames together.
+const maxPixels = 40_000_000
+
+const jpegQuality = 90
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrTooManyPixels     = errors.New("image dimensions too large")
+)
+
+// Image is a sanitized image ready to store.
+type Image struct {
+	Data   []byte
+	Width  int
+	Height int
+}
+
+// Sanitize decodes an image of the given MIME type and re-encodes it, which
+// drops EXIF and every other metadata block. JPEG orientation is applied to
+// the pixels first so that photos stay upright.
+func Sanitize(data []byte, mimeType string) (*Image, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	var buf bytes.Buffer
+	switch mimeType {
+	case "image/jpeg":
+		img, err := jpeg.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, ErrUnsupportedFormat
+		}
+		img = applyOrientation(img, jpegOrientation(data))
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, err
+		}
+		b := img.Bounds()
+		return &Image{Data: buf.Bytes(), Width: b.Dx(), Height: b.Dy()}, nil
+
+	case "image/png":
+		img, err := png.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, ErrUnsupportedFormat
+		}
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, err
+		}
+		b := img.Bounds()
+		return &Image{Data: buf.Bytes(), Width: b.Dx(), Height: b.Dy()}, nil
+
+	case "image/gif":
+		// Every frame gets decoded, so count them before committing the memory
+		frames, err := gifFrameCount(data)
+		if err != nil {
+			return nil, ErrUnsupportedFormat
+		}
+		if frames*cfg.Width*cfg.Height > maxPixels {
+			return nil, ErrTooManyPixels
+		}
+
+		// Decode every frame so animations survive
+		g, err := gif.DecodeAll(bytes.NewReader(data))
+		if err != nil {
+			return nil, ErrUnsupportedFormat
+		}
+		if err := gif.EncodeAll(&buf, g); err != nil {
+			return nil, err
+		}
+		return &Image{Data: buf.Bytes(), Width: g.Config.Width, Height: g.Config.Height}, nil
+	}
+
+	return nil, ErrUnsupportedFormat
+}
+
+var errMalformedGIF = errors.New("malformed gif")
+
+// gifFrameCount counts the image descriptors in a GIF by walking its block
+// structure, without decompressing any frame.
+func gifFrameCount(data []byte) (int, error) {
+	// Header and logical screen descriptor
+	if len(data) < 13 {
+		return 0, errMalformedGIF
+	}
+	pos := 13
+	if data[10]&0x80 != 0 {
+		pos += 3 << (data[10]&0x07 + 1)
+	}
+
+	frames := 0
+	for pos < len(data) {
+		switch data[pos] {
+		case 0x21: // extension: label, then data sub-blocks
+			pos += 2
+		case 0x2C: // image descriptor, optional local color table, LZW code size
+			if pos+10 > len(data) {
+				return 0, errMalformedGIF
+			}
+			flags := data[pos+9]
+			pos += 10
+			if flags&0x80 != 0 {
+				pos += 3 << (flags&0x07 + 1)
+			}
+			pos++
+			frames++
+		case 0x3B: // trailer
+			return frames, nil
+		default:
+			return 0, errMalformedGIF
+		}
+
+		// Skip data sub-blocks up to the zero-length terminator
+		for {
+			if pos >= len(data) {
+				return 0, errMalformedGIF
+			}
+			size := int(data[pos])
+			pos += size + 1
+			if size == 0 {
+				break
+			}
+		}
+	}
+	// Like image/gif, tolerate a missing trailer
+	return frames, nil
+}
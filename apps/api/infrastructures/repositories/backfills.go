@@ -0,0 +1,125 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+// BackfillConversationIDs sets conversation_id on posts created before the
+// column existed, walking each thread down from its root. Replies whose
+// parent is gone start their own conversation. It is a no-op once every post
+// has one, so it is safe to run on every start.
+func BackfillConversationIDs(db *gorm.DB) error {
+	var missing int64
+	if err := db.Unscoped().Model(&models.Post{}).Where("conversation_id = 0").Count(&missing).Error; err != nil {
+		return err
+	}
+	if missing == 0 {
+		return nil
+	}
+
+	return db.Exec(`
+		WITH RECURSIVE threads AS (
+			SELECT id, id AS root_id FROM posts
+			WHERE parent_id IS NULL
+				OR NOT EXISTS (SELECT 1 FROM posts parent WHERE parent.id = posts.parent_id)
+			UNION ALL
+			SELECT p.id, threads.root_id
+			FROM posts p JOIN threads ON p.parent_id = threads.id
+		)
+		UPDATE posts SET conversation_id = threads.root_id
+		FROM threads
+		WHERE posts.id = threads.id AND posts.conversation_id = 0`).Error
+}
+
+// BackfillPostKinds classifies posts created before the kind column existed,
+// which default to original. Only misclassified rows are touched, so it is
+// safe to run on every start. The posts they embed get their repost and
+// quote counts recomputed in the same transaction, since quotes used to be
+// counted as reposts.
+func BackfillPostKinds(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var embeddedIDs []uint
+		err := tx.Raw(`
+			WITH classified AS (
+				UPDATE posts SET kind = CASE
+					WHEN parent_id IS NOT NULL THEN 'reply'
+					WHEN content <> '' OR EXISTS (SELECT 1 FROM media WHERE media.post_id = posts.id) THEN 'quote'
+					ELSE 'repost'
+				END
+				WHERE kind = 'original' AND (parent_id IS NOT NULL OR repost_id IS NOT NULL)
+				RETURNING repost_id
+			)
+			SELECT DISTINCT repost_id FROM classified WHERE repost_id IS NOT NULL`).
+			Scan(&embeddedIDs).Error
+		if err != nil {
+			return err
+		}
+		return recountEmbeds(tx, embeddedIDs)
+	})
+}
+
+// DedupeReposts soft-deletes all but the first live plain repost of each
+// post by each author, so idx_posts_unique_repost can be built, and corrects
+// the repost counts of the affected posts in the same transaction.
+func DedupeReposts(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var embeddedIDs []uint
+		err := tx.Raw(`
+			WITH removed AS (
+				UPDATE posts SET deleted_at = NOW()
+				WHERE kind = 'repost' AND deleted_at IS NULL AND id NOT IN (
+					SELECT MIN(id) FROM posts
+					WHERE kind = 'repost' AND deleted_at IS NULL
+					GROUP BY author_id, repost_id
+				)
+				RETURNING repost_id
+			)
+			SELECT DISTINCT repost_id FROM removed`).
+			Scan(&embeddedIDs).Error
+		if err != nil {
+			return err
+		}
+		return recountEmbeds(tx, embeddedIDs)
+	})
+}
+
+// recountEmbeds recomputes repost_count and quote_count on postIDs from the
+// live posts that embed them, the same way ReconcileCounters does.
+func recountEmbeds(tx *gorm.DB, postIDs []uint) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	return tx.Exec(`
+		UPDATE posts SET
+			repost_count = (SELECT COUNT(*) FROM posts r WHERE r.repost_id = posts.id AND r.kind = 'repost' AND r.deleted_at IS NULL),
+			quote_count = (SELECT COUNT(*) FROM posts r WHERE r.repost_id = posts.id AND r.kind <> 'repost' AND r.deleted_at IS NULL)
+		WHERE id IN ?`, postIDs).Error
+}
+
+// DedupeNotifications deletes all but the first of each set of identical
+// notifications, which racing requests could create before
+// idx_notifications_unique existed. It returns without scanning the table
+// once the index is in place.
+func DedupeNotifications(db *gorm.DB) error {
+	exists, err := indexExists(db, "idx_notifications_unique")
+	if err != nil || exists {
+		return err
+	}
+
+	return db.Exec(`
+		DELETE FROM notifications
+		WHERE id NOT IN (
+			SELECT MIN(id) FROM notifications
+			GROUP BY recipient_id, actor_id, type, COALESCE(post_id, 0)
+		)`).Error
+}
+
+// indexExists reports whether an index named name exists in the current
+// schema.
+func indexExists(db *gorm.DB, name string) (bool, error) {
+	var exists bool
+	err := db.Raw("SELECT EXISTS (SELECT 1 FROM pg_indexes WHERE schemaname = current_schema() AND indexname = ?)", name).Scan(&exists).Error
+	return exists, err
+}
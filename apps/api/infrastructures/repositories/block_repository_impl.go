@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type blockRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewBlockRepository(db *gorm.DB) repositories.BlockRepository {
+	return &blockRepositoryImpl{db: db}
+}
+
+// Create records block. Blocking someone already blocked is a no-op.
+func (r *blockRepositoryImpl) Create(ctx context.Context, block *models.Block) error {
+	return dbFromContext(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(block).Error
+}
+
+func (r *blockRepositoryImpl) Delete(ctx context.Context, blockerID, blockedID uint) error {
+	return dbFromContext(ctx, r.db).Delete(&models.Block{}, "blocker_id = ? AND blocked_id = ?", blockerID, blockedID).Error
+}
+
+func (r *blockRepositoryImpl) Exists(ctx context.Context, blockerID, blockedID uint) (bool, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&models.Block{}).
+		Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *blockRepositoryImpl) ExistsBetween(ctx context.Context, userID, otherID uint) (bool, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&models.Block{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userID, otherID, otherID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *blockRepositoryImpl) ListBlocked(ctx context.Context, blockerID uint, limit, offset int) ([]*models.User, error) {
+	var users []*models.User
+	err := dbFromContext(ctx, r.db).
+		Joins("JOIN blocks ON blocks.blocked_id = users.id").
+		Where("blocks.blocker_id = ?", blockerID).
+		Order("blocks.created_at desc").
+		Limit(limit).
+		Offset(offset).
+		Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type BookmarkRepositoryImpl struct {
@@ -16,17 +17,21 @@ func NewBookmarkRepository(db *gorm.DB) repositories.BookmarkRepository {
 	return &BookmarkRepositoryImpl{db: db}
 }
 
-func (r *BookmarkRepositoryImpl) Create(ctx context.Context, bookmark *models.Bookmark) error {
-	return r.db.WithContext(ctx).Create(bookmark).Error
+// Create records bookmark and reports whether it was new. Bookmarking a post
+// twice, including from concurrent requests, is a no-op.
+func (r *BookmarkRepositoryImpl) Create(ctx context.Context, bookmark *models.Bookmark) (bool, error) {
+	result := dbFromContext(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(bookmark)
+	return result.RowsAffected > 0, result.Error
 }
 
-func (r *BookmarkRepositoryImpl) Delete(ctx context.Context, userID, postID uint) error {
-	return r.db.WithContext(ctx).Delete(&models.Bookmark{}, "user_id = ? AND post_id = ?", userID, postID).Error
+func (r *BookmarkRepositoryImpl) Delete(ctx context.Context, userID, postID uint) (bool, error) {
+	result := dbFromContext(ctx, r.db).Delete(&models.Bookmark{}, "user_id = ? AND post_id = ?", userID, postID)
+	return result.RowsAffected > 0, result.Error
 }
 
 func (r *BookmarkRepositoryImpl) Exists(ctx context.Context, userID, postID uint) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&models.Bookmark{}).
+	err := dbFromContext(ctx, r.db).Model(&models.Bookmark{}).
 		Where("user_id = ? AND post_id = ?", userID, postID).
 		Count(&count).Error
 	if err != nil {
@@ -35,10 +40,22 @@ func (r *BookmarkRepositoryImpl) Exists(ctx context.Context, userID, postID uint
 	return count > 0, nil
 }
 
-func (r *BookmarkRepositoryImpl) CountByPostID(ctx context.Context, postID uint) (int64, error) {
-	var count int64
-	err := r.db.WithContext(ctx).Model(&models.Bookmark{}).
-		Where("post_id = ?", postID).
-		Count(&count).Error
-	return count, err
+// FindPostIDsByUser reports which of postIDs the user has bookmarked.
+func (r *BookmarkRepositoryImpl) FindPostIDsByUser(ctx context.Context, userID uint, postIDs []uint) (map[uint]bool, error) {
+	result := make(map[uint]bool, len(postIDs))
+	if len(postIDs) == 0 {
+		return result, nil
+	}
+
+	var ids []uint
+	err := dbFromContext(ctx, r.db).Model(&models.Bookmark{}).
+		Where("user_id = ? AND post_id IN ?", userID, postIDs).
+		Pluck("post_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		result[id] = true
+	}
+	return result, nil
 }
@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type followRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewFollowRepository(db *gorm.DB) repositories.FollowRepository {
+	return &followRepositoryImpl{db: db}
+}
+
+// Create records follow and reports whether it was new. Following twice,
+// including from concurrent requests, is a no-op.
+func (r *followRepositoryImpl) Create(ctx context.Context, follow *models.Follow) (bool, error) {
+	result := dbFromContext(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(follow)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *followRepositoryImpl) Delete(ctx context.Context, followerID, followeeID uint) (bool, error) {
+	result := dbFromContext(ctx, r.db).Delete(&models.Follow{}, "follower_id = ? AND followee_id = ?", followerID, followeeID)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *followRepositoryImpl) Exists(ctx context.Context, followerID, followeeID uint) (bool, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&models.Follow{}).
+		Where("follower_id = ? AND followee_id = ?", followerID, followeeID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ListFollowers returns the users following userID, most recent follow first.
+func (r *followRepositoryImpl) ListFollowers(ctx context.Context, userID uint, limit, offset int) ([]*models.User, error) {
+	var users []*models.User
+	err := dbFromContext(ctx, r.db).
+		Joins("JOIN follows ON follows.follower_id = users.id").
+		Where("follows.followee_id = ?", userID).
+		Order("follows.created_at desc").
+		Limit(limit).
+		Offset(offset).
+		Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// ListFollowing returns the users userID follows, most recent follow first.
+func (r *followRepositoryImpl) ListFollowing(ctx context.Context, userID uint, limit, offset int) ([]*models.User, error) {
+	var users []*models.User
+	err := dbFromContext(ctx, r.db).
+		Joins("JOIN follows ON follows.followee_id = users.id").
+		Where("follows.follower_id = ?", userID).
+		Order("follows.created_at desc").
+		Limit(limit).
+		Offset(offset).
+		Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// ListFolloweeIDs returns the IDs of every account userID follows.
+func (r *followRepositoryImpl) ListFolloweeIDs(ctx context.Context, userID uint) ([]uint, error) {
+	var ids []uint
+	err := dbFromContext(ctx, r.db).Model(&models.Follow{}).
+		Where("follower_id = ?", userID).
+		Pluck("followee_id", &ids).Error
+	return ids, err
+}
+
+// ListFollowerIDs pages through the IDs of userID's followers in ascending
+// order, starting after afterID.
+func (r *followRepositoryImpl) ListFollowerIDs(ctx context.Context, userID uint, afterID uint, limit int) ([]uint, error) {
+	var ids []uint
+	err := dbFromContext(ctx, r.db).Model(&models.Follow{}).
+		Where("followee_id = ? AND follower_id > ?", userID, afterID).
+		Order("follower_id asc").
+		Limit(limit).
+		Pluck("follower_id", &ids).Error
+	return ids, err
+}
+
+// ListFolloweeIDsWithMinFollowers returns the accounts userID follows that
+// have at least minFollowers followers.
+func (r *followRepositoryImpl) ListFolloweeIDsWithMinFollowers(ctx context.Context, userID uint, minFollowers int64) ([]uint, error) {
+	var ids []uint
+	err := dbFromContext(ctx, r.db).Model(&models.Follow{}).
+		Joins("JOIN users ON users.id = follows.followee_id").
+		Where("follows.follower_id = ? AND users.follower_count >= ?", userID, minFollowers).
+		Pluck("follows.followee_id", &ids).Error
+	return ids, err
+}
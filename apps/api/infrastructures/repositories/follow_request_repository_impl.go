@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type followRequestRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewFollowRequestRepository(db *gorm.DB) repositories.FollowRequestRepository {
+	return &followRequestRepositoryImpl{db: db}
+}
+
+// Create records request. Requesting again while a request is pending is a
+// no-op.
+func (r *followRequestRepositoryImpl) Create(ctx context.Context, request *models.FollowRequest) error {
+	return dbFromContext(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(request).Error
+}
+
+func (r *followRequestRepositoryImpl) Delete(ctx context.Context, requesterID, targetID uint) (bool, error) {
+	result := dbFromContext(ctx, r.db).Delete(&models.FollowRequest{}, "requester_id = ? AND target_id = ?", requesterID, targetID)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *followRequestRepositoryImpl) Exists(ctx context.Context, requesterID, targetID uint) (bool, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&models.FollowRequest{}).
+		Where("requester_id = ? AND target_id = ?", requesterID, targetID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *followRequestRepositoryImpl) ListRequesters(ctx context.Context, targetID uint, limit, offset int) ([]*models.User, error) {
+	var users []*models.User
+	err := dbFromContext(ctx, r.db).
+		Joins("JOIN follow_requests ON follow_requests.requester_id = users.id").
+		Where("follow_requests.target_id = ?", targetID).
+		Order("follow_requests.created_at asc").
+		Limit(limit).
+		Offset(offset).
+		Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (r *followRequestRepositoryImpl) ListRequesterIDs(ctx context.Context, targetID uint) ([]uint, error) {
+	var ids []uint
+	err := dbFromContext(ctx, r.db).Model(&models.FollowRequest{}).
+		Where("target_id = ?", targetID).
+		Order("created_at asc").
+		Pluck("requester_id", &ids).Error
+	return ids, err
+}
@@ -0,0 +1,55 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type hashtagRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewHashtagRepository(db *gorm.DB) repositories.HashtagRepository {
+	return &hashtagRepositoryImpl{db: db}
+}
+
+func (r *hashtagRepositoryImpl) AttachToPost(ctx context.Context, postID uint, postCreatedAt time.Time, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	db := dbFromContext(ctx, r.db)
+
+	// Concurrent posts may introduce the same tag; let the unique index decide
+	hashtags := make([]models.Hashtag, 0, len(tags))
+	for _, tag := range tags {
+		hashtags = append(hashtags, models.Hashtag{Name: tag})
+	}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&hashtags).Error; err != nil {
+		return err
+	}
+
+	var ids []uint
+	if err := db.Model(&models.Hashtag{}).Where("name IN ?", tags).Pluck("id", &ids).Error; err != nil {
+		return err
+	}
+
+	links := make([]models.PostHashtag, 0, len(ids))
+	for _, id := range ids {
+		links = append(links, models.PostHashtag{
+			PostID:    postID,
+			HashtagID: id,
+			CreatedAt: postCreatedAt,
+		})
+	}
+	return db.Omit(clause.Associations).Create(&links).Error
+}
+
+func (r *hashtagRepositoryImpl) DetachFromPost(ctx context.Context, postID uint) error {
+	return dbFromContext(ctx, r.db).Where("post_id = ?", postID).Delete(&models.PostHashtag{}).Error
+}
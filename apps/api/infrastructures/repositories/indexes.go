@@ -0,0 +1,38 @@
+package repositories
+
+import "gorm.io/gorm"
+
+// postSearchVector must match the expression indexed by idx_posts_content_fts
+// for Postgres to use the index. The 'simple' configuration skips stemming
+// and stop words, which suits mixed-language posts.
+const postSearchVector = "to_tsvector('simple', posts.content)"
+
+// uniqueRepostPredicate must match the predicate of idx_posts_unique_repost
+// for ON CONFLICT to infer the index.
+const uniqueRepostPredicate = "kind = 'repost' AND deleted_at IS NULL"
+
+// indexStatements are indexes AutoMigrate cannot express through struct tags.
+var indexStatements = []string{
+	"CREATE INDEX IF NOT EXISTS idx_posts_content_fts ON posts USING GIN (to_tsvector('simple', content))",
+	// One live plain repost per user and post; quotes are not limited
+	"CREATE UNIQUE INDEX IF NOT EXISTS idx_posts_unique_repost ON posts (author_id, repost_id) WHERE " + uniqueRepostPredicate,
+	// One notification per recipient, actor, type and post. Follows have no
+	// post, so NULL is folded to 0 to make them collide as well.
+	"CREATE UNIQUE INDEX IF NOT EXISTS idx_notifications_unique ON notifications (recipient_id, actor_id, type, COALESCE(post_id, 0))",
+	// User search: prefix lookups on username plus trigram fuzzy matching
+	"CREATE EXTENSION IF NOT EXISTS pg_trgm",
+	"CREATE INDEX IF NOT EXISTS idx_users_username_prefix ON users (lower(username) text_pattern_ops)",
+	"CREATE INDEX IF NOT EXISTS idx_users_username_trgm ON users USING GIN (username gin_trgm_ops)",
+	"CREATE INDEX IF NOT EXISTS idx_users_bio_trgm ON users USING GIN (bio gin_trgm_ops)",
+}
+
+// CreateIndexes creates the indexes in indexStatements. It is safe to run on
+// every start.
+func CreateIndexes(db *gorm.DB) error {
+	for _, stmt := range indexStatements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type LikeRepositoryImpl struct {
@@ -16,17 +17,21 @@ func NewLikeRepository(db *gorm.DB) repositories.LikeRepository {
 	return &LikeRepositoryImpl{db: db}
 }
 
-func (r *LikeRepositoryImpl) Create(ctx context.Context, like *models.Like) error {
-	return r.db.WithContext(ctx).Create(like).Error
+// Create records like and reports whether it was new. Liking a post
+// twice, including from concurrent requests, is a no-op.
+func (r *LikeRepositoryImpl) Create(ctx context.Context, like *models.Like) (bool, error) {
+	result := dbFromContext(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(like)
+	return result.RowsAffected > 0, result.Error
 }
 
-func (r *LikeRepositoryImpl) Delete(ctx context.Context, userID, postID uint) error {
-	return r.db.WithContext(ctx).Delete(&models.Like{}, "user_id = ? AND post_id = ?", userID, postID).Error
+func (r *LikeRepositoryImpl) Delete(ctx context.Context, userID, postID uint) (bool, error) {
+	result := dbFromContext(ctx, r.db).Delete(&models.Like{}, "user_id = ? AND post_id = ?", userID, postID)
+	return result.RowsAffected > 0, result.Error
 }
 
 func (r *LikeRepositoryImpl) Exists(ctx context.Context, userID, postID uint) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&models.Like{}).
+	err := dbFromContext(ctx, r.db).Model(&models.Like{}).
 		Where("user_id = ? AND post_id = ?", userID, postID).
 		Count(&count).Error
 	if err != nil {
@@ -35,10 +40,39 @@ func (r *LikeRepositoryImpl) Exists(ctx context.Context, userID, postID uint) (b
 	return count > 0, nil
 }
 
-func (r *LikeRepositoryImpl) CountByPostID(ctx context.Context, postID uint) (int64, error) {
-	var count int64
-	err := r.db.WithContext(ctx).Model(&models.Like{}).
-		Where("post_id = ?", postID).
-		Count(&count).Error
-	return count, err
+// FindPostIDsByUser reports which of postIDs the user has liked.
+func (r *LikeRepositoryImpl) FindPostIDsByUser(ctx context.Context, userID uint, postIDs []uint) (map[uint]bool, error) {
+	result := make(map[uint]bool, len(postIDs))
+	if len(postIDs) == 0 {
+		return result, nil
+	}
+
+	var ids []uint
+	err := dbFromContext(ctx, r.db).Model(&models.Like{}).
+		Where("user_id = ? AND post_id IN ?", userID, postIDs).
+		Pluck("post_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		result[id] = true
+	}
+	return result, nil
+}
+
+func (r *LikeRepositoryImpl) ListLikers(ctx context.Context, postID, viewerID uint, limit, offset int) ([]*models.User, error) {
+	var users []*models.User
+	err := dbFromContext(ctx, r.db).
+		Joins("JOIN likes ON likes.user_id = users.id").
+		Where("likes.post_id = ?", postID).
+		Where("users.id NOT IN ("+blockedUsersSQL+")", viewerID, viewerID).
+		Order(gorm.Expr("EXISTS (SELECT 1 FROM follows WHERE follows.follower_id = ? AND follows.followee_id = users.id) desc", viewerID)).
+		Order("likes.created_at desc, users.id desc").
+		Limit(limit).
+		Offset(offset).
+		Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
 }
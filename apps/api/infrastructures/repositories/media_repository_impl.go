@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type mediaRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewMediaRepository(db *gorm.DB) repositories.MediaRepository {
+	return &mediaRepositoryImpl{db: db}
+}
+
+func (r *mediaRepositoryImpl) Create(ctx context.Context, media *models.Media) error {
+	return dbFromContext(ctx, r.db).Create(media).Error
+}
+
+func (r *mediaRepositoryImpl) FindByID(ctx context.Context, mediaID uint) (*models.Media, error) {
+	var media models.Media
+	if err := dbFromContext(ctx, r.db).First(&media, mediaID).Error; err != nil {
+		return nil, err
+	}
+	return &media, nil
+}
+
+func (r *mediaRepositoryImpl) FindByIDForUpdate(ctx context.Context, mediaID uint) (*models.Media, error) {
+	var media models.Media
+	err := dbFromContext(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&media, mediaID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &media, nil
+}
+
+func (r *mediaRepositoryImpl) ListStalePending(ctx context.Context, cutoff time.Time, limit int) ([]uint, error) {
+	var ids []uint
+	err := dbFromContext(ctx, r.db).Model(&models.Media{}).
+		Where("status = ? AND created_at < ?", models.MediaStatusPending, cutoff).
+		Order("id asc").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *mediaRepositoryImpl) AttachToPost(ctx context.Context, postID, ownerID uint, mediaIDs []uint) error {
+	db := dbFromContext(ctx, r.db)
+	for position, id := range mediaIDs {
+		result := db.Model(&models.Media{}).
+			Where("id = ? AND owner_id = ? AND post_id IS NULL", id, ownerID).
+			Updates(map[string]interface{}{"post_id": postID, "position": position})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainErrors.ErrInvalidInput
+		}
+	}
+	return nil
+}
+
+func (r *mediaRepositoryImpl) ListByPostID(ctx context.Context, postID uint) ([]*models.Media, error) {
+	var media []*models.Media
+	err := dbFromContext(ctx, r.db).
+		Where("post_id = ?", postID).
+		Preload("Variants", orderVariants).
+		Order("position asc").
+		Find(&media).Error
+	if err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+func (r *mediaRepositoryImpl) ListByPostIDs(ctx context.Context, postIDs []uint) ([]*models.Media, error) {
+	var media []*models.Media
+	if len(postIDs) == 0 {
+		return media, nil
+	}
+	err := dbFromContext(ctx, r.db).
+		Where("post_id IN ?", postIDs).
+		Preload("Variants", orderVariants).
+		Find(&media).Error
+	if err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+// DeleteByPostIDs removes the media rows of postIDs; variants follow by
+// cascade. Stored files are left to the caller.
+func (r *mediaRepositoryImpl) DeleteByPostIDs(ctx context.Context, postIDs []uint) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	return dbFromContext(ctx, r.db).Where("post_id IN ?", postIDs).Delete(&models.Media{}).Error
+}
+
+func (r *mediaRepositoryImpl) CreateVariants(ctx context.Context, variants []models.MediaVariant) error {
+	if len(variants) == 0 {
+		return nil
+	}
+	return dbFromContext(ctx, r.db).Create(&variants).Error
+}
+
+func (r *mediaRepositoryImpl) UpdateProcessing(ctx context.Context, mediaID uint, status, blurHash string) error {
+	return dbFromContext(ctx, r.db).Model(&models.Media{}).
+		Where("id = ?", mediaID).
+		Updates(map[string]interface{}{"status": status, "blur_hash": blurHash}).Error
+}
+
+// orderVariants preloads variants from narrowest to widest.
+func orderVariants(db *gorm.DB) *gorm.DB {
+	return db.Order("width asc")
+}
@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type muteRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewMuteRepository(db *gorm.DB) repositories.MuteRepository {
+	return &muteRepositoryImpl{db: db}
+}
+
+// Create records mute. Muting someone already muted is a no-op.
+func (r *muteRepositoryImpl) Create(ctx context.Context, mute *models.Mute) error {
+	return dbFromContext(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(mute).Error
+}
+
+func (r *muteRepositoryImpl) Delete(ctx context.Context, muterID, mutedID uint) error {
+	return dbFromContext(ctx, r.db).Delete(&models.Mute{}, "muter_id = ? AND muted_id = ?", muterID, mutedID).Error
+}
+
+func (r *muteRepositoryImpl) ListMuted(ctx context.Context, muterID uint, limit, offset int) ([]*models.User, error) {
+	var users []*models.User
+	err := dbFromContext(ctx, r.db).
+		Joins("JOIN mutes ON mutes.muted_id = users.id").
+		Where("mutes.muter_id = ?", muterID).
+		Order("mutes.created_at desc").
+		Limit(limit).
+		Offset(offset).
+		Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type mutedWordRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewMutedWordRepository(db *gorm.DB) repositories.MutedWordRepository {
+	return &mutedWordRepositoryImpl{db: db}
+}
+
+// Create inserts word, failing with ErrMutedWordExists if the user already
+// mutes the same word.
+func (r *mutedWordRepositoryImpl) Create(ctx context.Context, word *models.MutedWord) error {
+	result := dbFromContext(ctx, r.db).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "user_id"}, {Name: "word"}}, DoNothing: true}).
+		Create(word)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrMutedWordExists
+	}
+	return nil
+}
+
+func (r *mutedWordRepositoryImpl) FindByID(ctx context.Context, userID, id uint) (*models.MutedWord, error) {
+	var word models.MutedWord
+	err := dbFromContext(ctx, r.db).Where("user_id = ?", userID).First(&word, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrMutedWordNotFound
+		}
+		return nil, err
+	}
+	return &word, nil
+}
+
+func (r *mutedWordRepositoryImpl) Update(ctx context.Context, word *models.MutedWord) error {
+	return dbFromContext(ctx, r.db).Model(word).
+		Select("non_followed_only", "expires_at").
+		Updates(word).Error
+}
+
+func (r *mutedWordRepositoryImpl) Delete(ctx context.Context, userID, id uint) error {
+	result := dbFromContext(ctx, r.db).Where("user_id = ?", userID).Delete(&models.MutedWord{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrMutedWordNotFound
+	}
+	return nil
+}
+
+func (r *mutedWordRepositoryImpl) ListByUser(ctx context.Context, userID uint) ([]*models.MutedWord, error) {
+	var words []*models.MutedWord
+	err := dbFromContext(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("created_at desc, id desc").
+		Find(&words).Error
+	if err != nil {
+		return nil, err
+	}
+	return words, nil
+}
+
+func (r *mutedWordRepositoryImpl) ListActive(ctx context.Context, userID uint, now time.Time) ([]*models.MutedWord, error) {
+	var words []*models.MutedWord
+	err := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND (expires_at IS NULL OR expires_at > ?)", userID, now).
+		Find(&words).Error
+	if err != nil {
+		return nil, err
+	}
+	return words, nil
+}
@@ -0,0 +1,185 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+// groupActorLimit is how many recent actors are loaded per notification group
+const groupActorLimit = 3
+
+type notificationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) repositories.NotificationRepository {
+	return &notificationRepositoryImpl{db: db}
+}
+
+// Create saves the notification unless the actor has already been notified
+// about the same thing, so toggling a like off and on notifies only once.
+// idx_notifications_unique makes this hold for concurrent requests too.
+func (r *notificationRepositoryImpl) Create(ctx context.Context, notification *models.Notification) error {
+	return dbFromContext(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(notification).Error
+}
+
+type notificationGroupRow struct {
+	Type       string
+	PostID     *uint
+	ActorCount int64
+	LatestAt   time.Time
+	IsRead     bool
+}
+
+// ListGroups returns the recipient's notifications grouped by type and post,
+// most recently active group first.
+func (r *notificationRepositoryImpl) ListGroups(ctx context.Context, recipientID uint, limit, offset int) ([]*models.NotificationGroup, error) {
+	db := dbFromContext(ctx, r.db)
+
+	var rows []notificationGroupRow
+	err := db.Model(&models.Notification{}).
+		Select("type, post_id, COUNT(*) AS actor_count, MAX(created_at) AS latest_at, BOOL_AND(read_at IS NOT NULL) AS is_read").
+		Where("recipient_id = ?", recipientID).
+		Scopes(fromAudibleActors(recipientID)).
+		Group("type, post_id").
+		Order("latest_at desc").
+		Limit(limit).
+		Offset(offset).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return []*models.NotificationGroup{}, nil
+	}
+
+	types := make([]string, 0, len(rows))
+	postIDs := make([]uint, 0, len(rows))
+	for _, row := range rows {
+		types = append(types, row.Type)
+		if row.PostID != nil {
+			postIDs = append(postIDs, *row.PostID)
+		}
+	}
+
+	actors, err := r.recentActors(ctx, recipientID, types, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make(map[uint]*models.Post, len(postIDs))
+	if len(postIDs) > 0 {
+		var found []*models.Post
+		if err := withPostRelations(db).Where("id IN ?", postIDs).Find(&found).Error; err != nil {
+			return nil, err
+		}
+		for _, p := range found {
+			posts[p.ID] = p
+		}
+	}
+
+	groups := make([]*models.NotificationGroup, 0, len(rows))
+	for _, row := range rows {
+		group := &models.NotificationGroup{
+			Type:       row.Type,
+			PostID:     row.PostID,
+			ActorCount: row.ActorCount,
+			LatestAt:   row.LatestAt,
+			IsRead:     row.IsRead,
+			Actors:     actors[groupKey(row.Type, row.PostID)],
+		}
+		if row.PostID != nil {
+			group.Post = posts[*row.PostID]
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+type notificationKey struct {
+	Type   string
+	PostID uint
+}
+
+func groupKey(notificationType string, postID *uint) notificationKey {
+	key := notificationKey{Type: notificationType}
+	if postID != nil {
+		key.PostID = *postID
+	}
+	return key
+}
+
+// recentActors loads up to groupActorLimit of the most recent actors for each
+// (type, post) group in a single query.
+func (r *notificationRepositoryImpl) recentActors(ctx context.Context, recipientID uint, types []string, postIDs []uint) (map[notificationKey][]models.User, error) {
+	db := dbFromContext(ctx, r.db)
+
+	postFilter := db.Where("post_id IS NULL")
+	if len(postIDs) > 0 {
+		postFilter = postFilter.Or("post_id IN ?", postIDs)
+	}
+
+	ranked := db.Model(&models.Notification{}).
+		Select("id, ROW_NUMBER() OVER (PARTITION BY type, post_id ORDER BY created_at desc, id desc) AS rn").
+		Where("recipient_id = ? AND type IN ?", recipientID, types).
+		Where(postFilter).
+		Scopes(fromAudibleActors(recipientID))
+
+	var notifications []*models.Notification
+	err := db.Preload("Actor").
+		Where("id IN (?)", db.Table("(?) AS ranked", ranked).Select("id").Where("rn <= ?", groupActorLimit)).
+		Order("created_at desc, id desc").
+		Find(&notifications).Error
+	if err != nil {
+		return nil, err
+	}
+
+	actors := make(map[notificationKey][]models.User)
+	for _, n := range notifications {
+		key := groupKey(n.Type, n.PostID)
+		actors[key] = append(actors[key], n.Actor)
+	}
+	return actors, nil
+}
+
+// ListUnreadPosts loads only what MutedWordFilter needs: the post and the
+// post it embeds.
+func (r *notificationRepositoryImpl) ListUnreadPosts(ctx context.Context, recipientID uint) ([]*models.Post, error) {
+	db := dbFromContext(ctx, r.db)
+
+	unread := db.Model(&models.Notification{}).
+		Select("post_id").
+		Where("recipient_id = ? AND read_at IS NULL AND type IN ?", recipientID, models.MutableNotificationTypes).
+		Scopes(fromAudibleActors(recipientID))
+
+	var posts []*models.Post
+	if err := db.Preload("Repost", withDeleted).Where("id IN (?)", unread).Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+func (r *notificationRepositoryImpl) CountUnread(ctx context.Context, recipientID uint, hiddenPostIDs []uint) (int64, error) {
+	query := dbFromContext(ctx, r.db).Model(&models.Notification{}).
+		Where("recipient_id = ? AND read_at IS NULL", recipientID).
+		Scopes(fromAudibleActors(recipientID))
+	if len(hiddenPostIDs) > 0 {
+		query = query.Where("NOT (type IN ? AND post_id IN ?)", models.MutableNotificationTypes, hiddenPostIDs)
+	}
+
+	var count int64
+	err := query.Count(&count).Error
+	return count, err
+}
+
+func (r *notificationRepositoryImpl) MarkAllRead(ctx context.Context, recipientID uint) error {
+	return dbFromContext(ctx, r.db).Model(&models.Notification{}).
+		Where("recipient_id = ? AND read_at IS NULL", recipientID).
+		Update("read_at", time.Now()).Error
+}
@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+// seekBefore restricts query to rows strictly older than cursor when ordered
+// by (createdAtCol desc, idCol desc).
+func seekBefore(query *gorm.DB, createdAtCol, idCol string, cursor *models.Cursor) *gorm.DB {
+	if cursor == nil {
+		return query
+	}
+	return query.Where("("+createdAtCol+", "+idCol+") < (?, ?)", cursor.CreatedAt, cursor.ID)
+}
+
+// seekAfter restricts query to rows strictly newer than cursor when ordered
+// by (createdAtCol asc, idCol asc).
+func seekAfter(query *gorm.DB, createdAtCol, idCol string, cursor *models.Cursor) *gorm.DB {
+	if cursor == nil {
+		return query
+	}
+	return query.Where("("+createdAtCol+", "+idCol+") > (?, ?)", cursor.CreatedAt, cursor.ID)
+}
+
+// nextPostCursor trims posts fetched with limit+1 rows back to limit and
+// returns the cursor for the following page, or nil on the last page.
+func nextPostCursor(posts []*models.Post, limit int) ([]*models.Post, *models.Cursor) {
+	if len(posts) <= limit {
+		return posts, nil
+	}
+	posts = posts[:limit]
+	last := posts[len(posts)-1]
+	return posts, &models.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}
+}
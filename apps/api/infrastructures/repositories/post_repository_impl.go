@@ -2,8 +2,13 @@ package repositories
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
 
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
@@ -17,95 +22,653 @@ func NewPostRepository(db *gorm.DB) repositories.PostRepository {
 	return &postRepositoryImpl{db: db}
 }
 
+// Create inserts post. A post that does not reply to anything starts its
+// own conversation, so its conversation_id is set to its own ID. A second
+// plain repost of the same post by the same author fails with
+// ErrAlreadyReposted.
 func (r *postRepositoryImpl) Create(ctx context.Context, post *models.Post) error {
-	return r.db.WithContext(ctx).Create(post).Error
+	db := dbFromContext(ctx, r.db)
+	query := db
+	if post.Kind == models.PostKindRepost {
+		query = query.Clauses(clause.OnConflict{
+			Columns:     []clause.Column{{Name: "author_id"}, {Name: "repost_id"}},
+			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: uniqueRepostPredicate}}},
+			DoNothing:   true,
+		})
+	}
+	result := query.Create(post)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrAlreadyReposted
+	}
+	if post.ConversationID != 0 {
+		return nil
+	}
+	post.ConversationID = post.ID
+	return db.Model(&models.Post{}).Where("id = ?", post.ID).UpdateColumn("conversation_id", post.ID).Error
 }
 
-func (r *postRepositoryImpl) List(ctx context.Context, limit, offset int, targetUserID *uint) ([]*models.Post, error) {
+func (r *postRepositoryImpl) List(ctx context.Context, targetUserID *uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error) {
 	var posts []*models.Post
-	query := r.db.WithContext(ctx).
-		Preload("Author").
-		Preload("Repost").
-		Preload("Repost.Author").
-		Order("created_at desc").
-		Limit(limit).
-		Offset(offset)
+	query := withPostRelations(dbFromContext(ctx, r.db)).
+		Scopes(visibleTo(ctx)).
+		Order("created_at desc, id desc").
+		Limit(limit + 1)
 
 	if targetUserID != nil {
-		// Wait, if I want to show reposts by this user, I should check author_id.
-		// The query above is redundant. `author_id = ?` covers both regular posts and reposts created by this user.
-		// So just `Where("author_id = ?", *targetUserID)` is enough.
+		// `author_id = ?` covers both regular posts and reposts created by this user.
 		query = query.Where("author_id = ?", *targetUserID)
 	} else {
 		// Only show top-level posts in main timeline
-		query = query.Where("parent_id IS NULL")
+		query = query.Where("parent_id IS NULL").Scopes(unmutedFor(ctx))
 	}
+	query = seekBefore(query, "created_at", "id", cursor)
 
-	err := query.Find(&posts).Error
-	if err != nil {
-		return nil, err
+	if err := query.Find(&posts).Error; err != nil {
+		return nil, nil, err
 	}
-	return posts, nil
+	posts, next := nextPostCursor(posts, limit)
+	return posts, next, nil
 }
 
-func (r *postRepositoryImpl) GetBookmarkedPosts(ctx context.Context, userID uint, limit, offset int) ([]*models.Post, error) {
+// ListHomeTimeline returns top-level posts and reposts written by the user
+// or by anyone the user follows.
+func (r *postRepositoryImpl) ListHomeTimeline(ctx context.Context, userID uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error) {
 	var posts []*models.Post
-	err := r.db.WithContext(ctx).
-		Joins("JOIN bookmarks ON bookmarks.post_id = posts.id").
-		Where("bookmarks.user_id = ?", userID).
-		Preload("Author").
-		Preload("Repost").
-		Preload("Repost.Author").
-		Order("bookmarks.created_at desc").
+	followees := r.db.Model(&models.Follow{}).Select("followee_id").Where("follower_id = ?", userID)
+	query := withPostRelations(dbFromContext(ctx, r.db)).
+		Scopes(visibleTo(ctx), unmutedFor(ctx)).
+		Where("parent_id IS NULL").
+		Where("author_id = ? OR author_id IN (?)", userID, followees).
+		Order("created_at desc, id desc").
+		Limit(limit + 1)
+	query = seekBefore(query, "created_at", "id", cursor)
+
+	if err := query.Find(&posts).Error; err != nil {
+		return nil, nil, err
+	}
+	posts, next := nextPostCursor(posts, limit)
+	return posts, next, nil
+}
+
+// ListHomeTimelineEntries returns references to the newest posts of the
+// user's home timeline, used to warm the timeline cache.
+func (r *postRepositoryImpl) ListHomeTimelineEntries(ctx context.Context, userID uint, limit int) ([]models.TimelineEntry, error) {
+	var entries []models.TimelineEntry
+	followees := r.db.Model(&models.Follow{}).Select("followee_id").Where("follower_id = ?", userID)
+	err := dbFromContext(ctx, r.db).Model(&models.Post{}).
+		Select("id AS post_id, created_at").
+		Scopes(visibleTo(ctx), unmutedFor(ctx)).
+		Where("parent_id IS NULL").
+		Where("author_id = ? OR author_id IN (?)", userID, followees).
+		Order("created_at desc, id desc").
 		Limit(limit).
-		Offset(offset).
-		Find(&posts).Error
+		Scan(&entries).Error
+	return entries, err
+}
+
+// ListByAuthors returns top-level posts and reposts written by any of
+// authorIDs, newest first.
+func (r *postRepositoryImpl) ListByAuthors(ctx context.Context, authorIDs []uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error) {
+	if len(authorIDs) == 0 {
+		return []*models.Post{}, nil, nil
+	}
+
+	var posts []*models.Post
+	query := withPostRelations(dbFromContext(ctx, r.db)).
+		Scopes(visibleTo(ctx), unmutedFor(ctx)).
+		Where("parent_id IS NULL AND author_id IN ?", authorIDs).
+		Order("created_at desc, id desc").
+		Limit(limit + 1)
+	query = seekBefore(query, "created_at", "id", cursor)
 
+	if err := query.Find(&posts).Error; err != nil {
+		return nil, nil, err
+	}
+	posts, next := nextPostCursor(posts, limit)
+	return posts, next, nil
+}
+
+// GetBookmarkedPosts pages through the user's bookmarks by the time they were
+// bookmarked, newest first. The cursor encodes (bookmarks.created_at, post_id).
+func (r *postRepositoryImpl) GetBookmarkedPosts(ctx context.Context, userID uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error) {
+	var bookmarks []models.Bookmark
+	query := dbFromContext(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("created_at desc, post_id desc").
+		Limit(limit + 1)
+	query = seekBefore(query, "created_at", "post_id", cursor)
+	if err := query.Find(&bookmarks).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var next *models.Cursor
+	if len(bookmarks) > limit {
+		bookmarks = bookmarks[:limit]
+		last := bookmarks[len(bookmarks)-1]
+		next = &models.Cursor{CreatedAt: last.CreatedAt, ID: last.PostID}
+	}
+
+	postIDs := make([]uint, 0, len(bookmarks))
+	for _, b := range bookmarks {
+		postIDs = append(postIDs, b.PostID)
+	}
+	posts, err := r.findOrdered(ctx, postIDs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return posts, nil
+	return posts, next, nil
 }
 
-func (r *postRepositoryImpl) CountReplies(ctx context.Context, postID uint) (int64, error) {
-	var count int64
-	err := r.db.WithContext(ctx).Model(&models.Post{}).Where("parent_id = ?", postID).Count(&count).Error
-	return count, err
+// GetLikedPosts pages through the posts userID liked by the time they were
+// liked, newest first. The cursor encodes (likes.created_at, post_id), not
+// the post's own time. Posts hidden from the viewer in ctx are dropped after
+// paging, so a page can come back short.
+func (r *postRepositoryImpl) GetLikedPosts(ctx context.Context, userID uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error) {
+	var likes []models.Like
+	query := dbFromContext(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("created_at desc, post_id desc").
+		Limit(limit + 1)
+	query = seekBefore(query, "created_at", "post_id", cursor)
+	if err := query.Find(&likes).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var next *models.Cursor
+	if len(likes) > limit {
+		likes = likes[:limit]
+		last := likes[len(likes)-1]
+		next = &models.Cursor{CreatedAt: last.CreatedAt, ID: last.PostID}
+	}
+
+	postIDs := make([]uint, 0, len(likes))
+	for _, l := range likes {
+		postIDs = append(postIDs, l.PostID)
+	}
+	posts, err := r.findOrdered(ctx, postIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return posts, next, nil
+}
+
+// ListByHashtag pages through the posts tagged with tag, newest first. The
+// cursor encodes (post_hashtags.created_at, post_id).
+func (r *postRepositoryImpl) ListByHashtag(ctx context.Context, tag string, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error) {
+	var links []models.PostHashtag
+	query := dbFromContext(ctx, r.db).
+		Joins("JOIN hashtags ON hashtags.id = post_hashtags.hashtag_id").
+		Where("hashtags.name = ?", tag).
+		Order("post_hashtags.created_at desc, post_hashtags.post_id desc").
+		Limit(limit + 1)
+	query = seekBefore(query, "post_hashtags.created_at", "post_hashtags.post_id", cursor)
+	if err := query.Find(&links).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var next *models.Cursor
+	if len(links) > limit {
+		links = links[:limit]
+		last := links[len(links)-1]
+		next = &models.Cursor{CreatedAt: last.CreatedAt, ID: last.PostID}
+	}
+
+	postIDs := make([]uint, 0, len(links))
+	for _, l := range links {
+		postIDs = append(postIDs, l.PostID)
+	}
+	posts, err := r.findOrdered(ctx, postIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return posts, next, nil
+}
+
+// Search pages through posts matching query, newest first. Free text is
+// matched with websearch_to_tsquery, so "quoted phrases", OR and -word work.
+func (r *postRepositoryImpl) Search(ctx context.Context, query *models.PostSearchQuery, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error) {
+	db := dbFromContext(ctx, r.db)
+
+	q := withPostRelations(db).
+		Scopes(visibleTo(ctx)).
+		Order("created_at desc, id desc").
+		Limit(limit + 1)
+	if query.Text != "" {
+		q = q.Where(postSearchVector+" @@ websearch_to_tsquery('simple', ?)", query.Text)
+	}
+	if query.FromUsername != "" {
+		q = q.Where("author_id = (?)", db.Model(&models.User{}).Select("id").Where("username = ?", query.FromUsername))
+	}
+	if query.Since != nil {
+		q = q.Where("created_at >= ?", *query.Since)
+	}
+	if query.Until != nil {
+		q = q.Where("created_at < ?", *query.Until)
+	}
+	if query.HasReplies {
+		q = q.Where("reply_count > 0")
+	}
+	q = seekBefore(q, "created_at", "id", cursor)
+
+	var posts []*models.Post
+	if err := q.Find(&posts).Error; err != nil {
+		return nil, nil, err
+	}
+
+	posts, next := nextPostCursor(posts, limit)
+	return posts, next, nil
+}
+
+// ListMentioning pages through the posts that mention userID, newest first.
+func (r *postRepositoryImpl) ListMentioning(ctx context.Context, userID uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error) {
+	db := dbFromContext(ctx, r.db)
+
+	var posts []*models.Post
+	query := withPostRelations(db).
+		Scopes(visibleTo(ctx)).
+		Where("id IN (?)", db.Model(&models.PostMention{}).Select("post_id").Where("user_id = ?", userID)).
+		Order("created_at desc, id desc").
+		Limit(limit + 1)
+	query = seekBefore(query, "created_at", "id", cursor)
+
+	if err := query.Find(&posts).Error; err != nil {
+		return nil, nil, err
+	}
+
+	posts, next := nextPostCursor(posts, limit)
+	return posts, next, nil
 }
 
-func (r *postRepositoryImpl) CountReposts(ctx context.Context, postID uint) (int64, error) {
+func (r *postRepositoryImpl) ListQuotes(ctx context.Context, postID uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error) {
+	var posts []*models.Post
+	query := withPostRelations(dbFromContext(ctx, r.db)).
+		Scopes(visibleTo(ctx)).
+		Where("repost_id = ? AND kind <> ?", postID, models.PostKindRepost).
+		Order("created_at desc, id desc").
+		Limit(limit + 1)
+	query = seekBefore(query, "created_at", "id", cursor)
+
+	if err := query.Find(&posts).Error; err != nil {
+		return nil, nil, err
+	}
+
+	posts, next := nextPostCursor(posts, limit)
+	return posts, next, nil
+}
+
+func (r *postRepositoryImpl) CountByAuthorID(ctx context.Context, authorID uint) (int64, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&models.Post{}).Where("repost_id = ?", postID).Count(&count).Error
+	err := dbFromContext(ctx, r.db).Model(&models.Post{}).Where("author_id = ?", authorID).Count(&count).Error
 	return count, err
 }
 
 func (r *postRepositoryImpl) CheckReposted(ctx context.Context, userID uint, postID uint) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&models.Post{}).
-		Where("author_id = ? AND repost_id = ?", userID, postID).
+	err := dbFromContext(ctx, r.db).Model(&models.Post{}).
+		Where("author_id = ? AND repost_id = ? AND kind = ?", userID, postID, models.PostKindRepost).
 		Count(&count).Error
 	return count > 0, err
 }
 
-func (r *postRepositoryImpl) GetReplies(ctx context.Context, postID uint) ([]*models.Post, error) {
+// FindRepostedPostIDs reports which of postIDs the user has reposted.
+// Quotes do not count as reposts.
+func (r *postRepositoryImpl) FindRepostedPostIDs(ctx context.Context, userID uint, postIDs []uint) (map[uint]bool, error) {
+	result := make(map[uint]bool, len(postIDs))
+	if len(postIDs) == 0 {
+		return result, nil
+	}
+
+	var ids []uint
+	err := dbFromContext(ctx, r.db).Model(&models.Post{}).
+		Where("author_id = ? AND repost_id IN ? AND kind = ?", userID, postIDs, models.PostKindRepost).
+		Pluck("repost_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		result[id] = true
+	}
+	return result, nil
+}
+
+// FindRepliablePostIDs skips deleted posts and posts hidden from the viewer.
+func (r *postRepositoryImpl) FindRepliablePostIDs(ctx context.Context, userID uint, postIDs []uint) (map[uint]bool, error) {
+	result := make(map[uint]bool, len(postIDs))
+	if len(postIDs) == 0 {
+		return result, nil
+	}
+
+	var ids []uint
+	err := dbFromContext(ctx, r.db).Model(&models.Post{}).
+		Scopes(visibleTo(ctx)).
+		Where("posts.id IN ?", postIDs).
+		Where(`posts.reply_policy = ? OR posts.author_id = ?
+			OR EXISTS (SELECT 1 FROM post_mentions WHERE post_mentions.post_id = posts.id AND post_mentions.user_id = ?)
+			OR (posts.reply_policy = ? AND EXISTS (SELECT 1 FROM follows WHERE follows.follower_id = posts.author_id AND follows.followee_id = ?))`,
+			models.ReplyPolicyEveryone, userID, userID, models.ReplyPolicyFollowing, userID).
+		Pluck("posts.id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		result[id] = true
+	}
+	return result, nil
+}
+
+func (r *postRepositoryImpl) FindRepost(ctx context.Context, userID uint, postID uint) (*models.Post, error) {
+	var post models.Post
+	err := dbFromContext(ctx, r.db).
+		Where("author_id = ? AND repost_id = ? AND kind = ?", userID, postID, models.PostKindRepost).
+		First(&post).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrPostNotFound
+		}
+		return nil, err
+	}
+	return &post, nil
+}
+
+// GetReplies pages through the direct replies to a post, oldest first.
+func (r *postRepositoryImpl) GetReplies(ctx context.Context, postID uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error) {
 	var replies []*models.Post
-	err := r.db.WithContext(ctx).
+	// Deleted replies that others answered stay in the thread as tombstones
+	query := dbFromContext(ctx, r.db).
+		Unscoped().
 		Where("parent_id = ?", postID).
+		Where("deleted_at IS NULL OR reply_count > 0").
+		Scopes(visibleTo(ctx), unmutedFor(ctx)).
 		Preload("Author").
-		Order("created_at asc").
-		Find(&replies).Error
-	return replies, err
+		Preload("Mentions").
+		Preload("Media", orderMedia).
+		Preload("Media.Variants", orderVariants).
+		Order("created_at asc, id asc").
+		Limit(limit + 1)
+	query = seekAfter(query, "created_at", "id", cursor)
+
+	if err := query.Find(&replies).Error; err != nil {
+		return nil, nil, err
+	}
+	replies, next := nextPostCursor(replies, limit)
+	return replies, next, nil
 }
 
-func (r *postRepositoryImpl) Delete(ctx context.Context, postID uint) error {
-	return r.db.WithContext(ctx).Delete(&models.Post{}, postID).Error
+// Delete soft-deletes the post and reports whether it was still live.
+func (r *postRepositoryImpl) Delete(ctx context.Context, postID uint) (bool, error) {
+	result := dbFromContext(ctx, r.db).Delete(&models.Post{}, postID)
+	return result.RowsAffected > 0, result.Error
 }
 
 func (r *postRepositoryImpl) FindByID(ctx context.Context, postID uint) (*models.Post, error) {
 	var post models.Post
-	err := r.db.WithContext(ctx).First(&post, postID).Error
+	err := dbFromContext(ctx, r.db).Scopes(visibleTo(ctx)).Preload("Mentions").Preload("Media", orderMedia).Preload("Media.Variants", orderVariants).First(&post, postID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrPostNotFound
+		}
+		return nil, err
+	}
+	return &post, nil
+}
+
+// FindByIDIncludingDeleted is FindByID that also returns tombstones.
+func (r *postRepositoryImpl) FindByIDIncludingDeleted(ctx context.Context, postID uint) (*models.Post, error) {
+	var post models.Post
+	err := dbFromContext(ctx, r.db).Unscoped().Scopes(visibleTo(ctx)).Preload("Mentions").Preload("Media", orderMedia).Preload("Media.Variants", orderVariants).First(&post, postID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrPostNotFound
+		}
+		return nil, err
+	}
+	return &post, nil
+}
+
+func (r *postRepositoryImpl) GetThreadPath(ctx context.Context, postID uint, maxAncestors int) ([]*models.Post, error) {
+	var ids []uint
+	err := dbFromContext(ctx, r.db).Raw(`
+		WITH RECURSIVE path AS (
+			SELECT id, parent_id, 0 AS depth FROM posts WHERE id = ?
+			UNION ALL
+			SELECT p.id, p.parent_id, path.depth + 1
+			FROM posts p JOIN path ON p.id = path.parent_id
+			WHERE path.depth < ?
+		)
+		SELECT id FROM path ORDER BY depth DESC`, postID, maxAncestors).Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, domainErrors.ErrPostNotFound
+	}
+	return loadOrdered(dbFromContext(ctx, r.db).Unscoped().Scopes(visibleTo(ctx)), ids)
+}
+
+// replyRankings maps each ReplyOrder to its ORDER BY expression within a
+// level of the reply tree.
+var replyRankings = map[repositories.ReplyOrder]string{
+	repositories.ReplyOrderEngagement: "posts.like_count + 2 * posts.reply_count + 2 * posts.repost_count DESC, posts.created_at ASC",
+	repositories.ReplyOrderNewest:     "posts.created_at DESC",
+	repositories.ReplyOrderOldest:     "posts.created_at ASC",
+}
+
+func (r *postRepositoryImpl) ListReplyTree(ctx context.Context, postID, conversationID uint, maxDepth int, order repositories.ReplyOrder, limit int) ([]*models.Post, error) {
+	ranking, ok := replyRankings[order]
+	if !ok {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	// conversation_id keeps every level of the walk on one index range
+	var ids []uint
+	err := dbFromContext(ctx, r.db).Raw(`
+		WITH RECURSIVE tree AS (
+			SELECT id, 1 AS depth FROM posts
+			WHERE parent_id = ? AND conversation_id = ?
+			UNION ALL
+			SELECT p.id, tree.depth + 1
+			FROM posts p JOIN tree ON p.parent_id = tree.id
+			WHERE p.conversation_id = ? AND tree.depth < ?
+		)
+		SELECT posts.id FROM tree JOIN posts ON posts.id = tree.id
+		WHERE posts.deleted_at IS NULL OR posts.reply_count > 0
+		ORDER BY tree.depth, `+ranking+`, posts.id
+		LIMIT ?`, postID, conversationID, conversationID, maxDepth, limit).Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	// Hidden replies drop out along with everything below them
+	return loadOrdered(dbFromContext(ctx, r.db).Unscoped().Scopes(visibleTo(ctx), unmutedFor(ctx)), ids)
+}
+
+func (r *postRepositoryImpl) FindByIDForUpdate(ctx context.Context, postID uint) (*models.Post, error) {
+	var post models.Post
+	err := dbFromContext(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&post, postID).Error
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrPostNotFound
+		}
 		return nil, err
 	}
 	return &post, nil
 }
+
+// UpdateContent replaces the post's content and counts the edit.
+func (r *postRepositoryImpl) UpdateContent(ctx context.Context, postID uint, content string, editedAt time.Time) error {
+	return dbFromContext(ctx, r.db).Model(&models.Post{}).
+		Where("id = ?", postID).
+		Updates(map[string]interface{}{
+			"content":        content,
+			"edited_at":      editedAt,
+			"revision_count": gorm.Expr("revision_count + 1"),
+			"updated_at":     editedAt,
+		}).Error
+}
+
+// ReplaceMentions swaps the post's stored mentions for mentions.
+func (r *postRepositoryImpl) ReplaceMentions(ctx context.Context, postID uint, mentions []models.PostMention) error {
+	db := dbFromContext(ctx, r.db)
+	if err := db.Where("post_id = ?", postID).Delete(&models.PostMention{}).Error; err != nil {
+		return err
+	}
+	if len(mentions) == 0 {
+		return nil
+	}
+	for i := range mentions {
+		mentions[i].PostID = postID
+	}
+	return db.Omit(clause.Associations).Create(&mentions).Error
+}
+
+func (r *postRepositoryImpl) IncrementCounter(ctx context.Context, postID uint, counter repositories.PostCounter, delta int64) error {
+	column := string(counter)
+	// Tombstones keep their counters so threads under them stay visible
+	return dbFromContext(ctx, r.db).Unscoped().Model(&models.Post{}).
+		Where("id = ?", postID).
+		UpdateColumn(column, gorm.Expr(column+" + ?", delta)).Error
+}
+
+// ReconcileCounters recomputes every engagement counter from the likes,
+// bookmarks and posts tables and returns the number of posts that drifted.
+// Deleted replies, reposts and quotes are not counted.
+func (r *postRepositoryImpl) ReconcileCounters(ctx context.Context) (int64, error) {
+	result := dbFromContext(ctx, r.db).Exec(`
+		UPDATE posts SET
+			like_count = c.like_count,
+			bookmark_count = c.bookmark_count,
+			reply_count = c.reply_count,
+			repost_count = c.repost_count,
+			quote_count = c.quote_count
+		FROM (
+			SELECT p.id,
+				(SELECT COUNT(*) FROM likes l WHERE l.post_id = p.id) AS like_count,
+				(SELECT COUNT(*) FROM bookmarks b WHERE b.post_id = p.id) AS bookmark_count,
+				(SELECT COUNT(*) FROM posts r WHERE r.parent_id = p.id AND r.deleted_at IS NULL) AS reply_count,
+				(SELECT COUNT(*) FROM posts r WHERE r.repost_id = p.id AND r.kind = 'repost' AND r.deleted_at IS NULL) AS repost_count,
+				(SELECT COUNT(*) FROM posts r WHERE r.repost_id = p.id AND r.kind <> 'repost' AND r.deleted_at IS NULL) AS quote_count
+			FROM posts p
+		) c
+		WHERE posts.id = c.id AND (
+			posts.like_count <> c.like_count OR
+			posts.bookmark_count <> c.bookmark_count OR
+			posts.reply_count <> c.reply_count OR
+			posts.repost_count <> c.repost_count OR
+			posts.quote_count <> c.quote_count
+		)`)
+	return result.RowsAffected, result.Error
+}
+
+func (r *postRepositoryImpl) ListPurgeable(ctx context.Context, cutoff time.Time, limit int) ([]uint, error) {
+	var ids []uint
+	err := dbFromContext(ctx, r.db).Unscoped().Model(&models.Post{}).
+		Where("deleted_at < ? AND purged_at IS NULL", cutoff).
+		Order("id asc").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *postRepositoryImpl) PurgeContent(ctx context.Context, postIDs []uint, purgedAt time.Time) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	db := dbFromContext(ctx, r.db)
+	for _, model := range []interface{}{
+		&models.PostMention{},
+		&models.PostHashtag{},
+		&models.PostRevision{},
+		&models.Like{},
+		&models.Bookmark{},
+		&models.Notification{},
+	} {
+		if err := db.Where("post_id IN ?", postIDs).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+	return db.Unscoped().Model(&models.Post{}).
+		Where("id IN ?", postIDs).
+		UpdateColumns(map[string]interface{}{"content": "", "purged_at": purgedAt}).Error
+}
+
+func (r *postRepositoryImpl) DeleteUnreferenced(ctx context.Context, postIDs []uint) (int64, error) {
+	if len(postIDs) == 0 {
+		return 0, nil
+	}
+	result := dbFromContext(ctx, r.db).Unscoped().
+		Where("id IN ?", postIDs).
+		Where("NOT EXISTS (SELECT 1 FROM posts c WHERE c.parent_id = posts.id OR c.repost_id = posts.id)").
+		Delete(&models.Post{})
+	return result.RowsAffected, result.Error
+}
+
+// FindByIDs loads posts with their relations in the order of postIDs. Posts
+// the viewer has muted are skipped along with hidden ones, since the IDs come
+// from cached feeds.
+func (r *postRepositoryImpl) FindByIDs(ctx context.Context, postIDs []uint) ([]*models.Post, error) {
+	return loadOrdered(dbFromContext(ctx, r.db).Scopes(visibleTo(ctx), unmutedFor(ctx)), postIDs)
+}
+
+// findOrdered loads posts with their relations and returns them in the order
+// of postIDs. Deleted posts, posts hidden from the viewer and IDs that no
+// longer exist are skipped.
+func (r *postRepositoryImpl) findOrdered(ctx context.Context, postIDs []uint) ([]*models.Post, error) {
+	return loadOrdered(dbFromContext(ctx, r.db).Scopes(visibleTo(ctx)), postIDs)
+}
+
+// loadOrdered loads postIDs with their relations through db and returns them
+// in the order of postIDs. IDs that no longer exist are skipped.
+func loadOrdered(db *gorm.DB, postIDs []uint) ([]*models.Post, error) {
+	if len(postIDs) == 0 {
+		return []*models.Post{}, nil
+	}
+
+	var found []*models.Post
+	if err := withPostRelations(db).Where("id IN ?", postIDs).Find(&found).Error; err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uint]*models.Post, len(found))
+	for _, p := range found {
+		byID[p.ID] = p
+	}
+	posts := make([]*models.Post, 0, len(found))
+	for _, id := range postIDs {
+		if p, ok := byID[id]; ok {
+			posts = append(posts, p)
+		}
+	}
+	return posts, nil
+}
+
+// withPostRelations preloads what a post needs to be rendered in a list.
+func withPostRelations(query *gorm.DB) *gorm.DB {
+	return query.
+		Preload("Author").
+		Preload("Mentions").
+		Preload("Media", orderMedia).
+		Preload("Media.Variants", orderVariants).
+		Preload("Repost", withDeleted).
+		Preload("Repost.Author").
+		Preload("Repost.Mentions").
+		Preload("Repost.Media", orderMedia).
+		Preload("Repost.Media.Variants", orderVariants)
+}
+
+// withDeleted lets a preload include soft-deleted posts so they can be
+// rendered as tombstones.
+func withDeleted(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
+
+// orderMedia preloads attachments in the order they were attached.
+func orderMedia(db *gorm.DB) *gorm.DB {
+	return db.Order("position asc")
+}
@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type postRevisionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPostRevisionRepository(db *gorm.DB) repositories.PostRevisionRepository {
+	return &postRevisionRepositoryImpl{db: db}
+}
+
+func (r *postRevisionRepositoryImpl) Create(ctx context.Context, revision *models.PostRevision) error {
+	return dbFromContext(ctx, r.db).Omit(clause.Associations).Create(revision).Error
+}
+
+// ListByPostID returns a post's earlier versions, newest first.
+func (r *postRevisionRepositoryImpl) ListByPostID(ctx context.Context, postID uint) ([]*models.PostRevision, error) {
+	var revisions []*models.PostRevision
+	err := dbFromContext(ctx, r.db).
+		Where("post_id = ?", postID).
+		Order("created_at desc, id desc").
+		Find(&revisions).Error
+	if err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type txKey struct{}
+
+type transactionManagerImpl struct {
+	db *gorm.DB
+}
+
+func NewTransactionManager(db *gorm.DB) repositories.TransactionManager {
+	return &transactionManagerImpl{db: db}
+}
+
+func (m *transactionManagerImpl) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Nested calls reuse the outer transaction
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// dbFromContext returns the transaction stored in ctx, or db when ctx is not
+// part of a transaction.
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -2,8 +2,8 @@ package repositories
 
 import (
 	"context"
-
 	"errors"
+	"strings"
 
 	"gorm.io/gorm"
 
@@ -21,12 +21,12 @@ func NewUserRepository(db *gorm.DB) repositories.UserRepository {
 }
 
 func (r *userRepositoryImpl) Create(ctx context.Context, user *models.User) error {
-	return r.db.WithContext(ctx).Create(user).Error
+	return dbFromContext(ctx, r.db).Create(user).Error
 }
 
 func (r *userRepositoryImpl) FindByEmail(ctx context.Context, email string) (*models.User, error) {
 	var user models.User
-	err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error
+	err := dbFromContext(ctx, r.db).Where("email = ?", email).First(&user).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, domainErrors.ErrUserNotFound
@@ -38,7 +38,7 @@ func (r *userRepositoryImpl) FindByEmail(ctx context.Context, email string) (*mo
 
 func (r *userRepositoryImpl) FindByUsername(ctx context.Context, username string) (*models.User, error) {
 	var user models.User
-	if err := r.db.WithContext(ctx).Where("username = ?", username).First(&user).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Where("username = ?", username).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, domainErrors.ErrUserNotFound
 		}
@@ -47,9 +47,22 @@ func (r *userRepositoryImpl) FindByUsername(ctx context.Context, username string
 	return &user, nil
 }
 
+// FindByUsernames returns the users matching usernames. Unknown usernames are
+// skipped.
+func (r *userRepositoryImpl) FindByUsernames(ctx context.Context, usernames []string) ([]*models.User, error) {
+	var users []*models.User
+	if len(usernames) == 0 {
+		return users, nil
+	}
+	if err := dbFromContext(ctx, r.db).Where("username IN ?", usernames).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
 func (r *userRepositoryImpl) FindByID(ctx context.Context, id uint) (*models.User, error) {
 	var user models.User
-	if err := r.db.WithContext(ctx).First(&user, id).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).First(&user, id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, domainErrors.ErrUserNotFound
 		}
@@ -57,3 +70,131 @@ func (r *userRepositoryImpl) FindByID(ctx context.Context, id uint) (*models.Use
 	}
 	return &user, nil
 }
+
+// UpdatePrivacy switches the user between a public and a private account.
+// Pending follow requests are settled by the caller.
+func (r *userRepositoryImpl) UpdatePrivacy(ctx context.Context, userID uint, isPrivate bool) error {
+	return dbFromContext(ctx, r.db).Model(&models.User{}).Where("id = ?", userID).Update("is_private", isPrivate).Error
+}
+
+// UpdateProfile saves the user's editable text fields, including clearing
+// ones set to their zero value. Image columns are only written by
+// SetProfileImage.
+func (r *userRepositoryImpl) UpdateProfile(ctx context.Context, user *models.User) error {
+	return dbFromContext(ctx, r.db).Model(user).
+		Select("display_name", "bio", "location", "website", "birthday", "birthday_visibility").
+		Updates(user).Error
+}
+
+// profileImageColumns maps each profile image to the prefix of its key and
+// url columns.
+var profileImageColumns = map[models.ProfileImage]string{
+	models.ProfileImageAvatar: "avatar",
+	models.ProfileImageBanner: "banner",
+}
+
+// SetProfileImage swaps one profile image in a single statement. The row is
+// locked while the old key is read, so concurrent uploads each get back the
+// key they actually replaced.
+func (r *userRepositoryImpl) SetProfileImage(ctx context.Context, userID uint, kind models.ProfileImage, key, url string) (string, error) {
+	column, ok := profileImageColumns[kind]
+	if !ok {
+		return "", domainErrors.ErrInvalidInput
+	}
+
+	var oldKeys []string
+	err := dbFromContext(ctx, r.db).Raw(`
+		UPDATE users SET `+column+`_key = ?, `+column+`_url = ?, updated_at = NOW()
+		FROM (SELECT id, `+column+`_key AS old_key FROM users WHERE id = ? FOR UPDATE) old
+		WHERE users.id = old.id
+		RETURNING old.old_key`, key, url, userID).
+		Scan(&oldKeys).Error
+	if err != nil {
+		return "", err
+	}
+	if len(oldKeys) == 0 {
+		return "", domainErrors.ErrUserNotFound
+	}
+	return oldKeys[0], nil
+}
+
+// IncrementFollowCounts adjusts the follower's following_count and the
+// followee's follower_count by delta.
+func (r *userRepositoryImpl) IncrementFollowCounts(ctx context.Context, followerID, followeeID uint, delta int64) error {
+	db := dbFromContext(ctx, r.db)
+	if err := db.Model(&models.User{}).Where("id = ?", followerID).
+		UpdateColumn("following_count", gorm.Expr("following_count + ?", delta)).Error; err != nil {
+		return err
+	}
+	return db.Model(&models.User{}).Where("id = ?", followeeID).
+		UpdateColumn("follower_count", gorm.Expr("follower_count + ?", delta)).Error
+}
+
+// ReconcileFollowCounts recomputes follower and following counts from the
+// follows table and returns the number of users that drifted.
+func (r *userRepositoryImpl) ReconcileFollowCounts(ctx context.Context) (int64, error) {
+	result := dbFromContext(ctx, r.db).Exec(`
+		UPDATE users SET
+			follower_count = c.follower_count,
+			following_count = c.following_count
+		FROM (
+			SELECT u.id,
+				(SELECT COUNT(*) FROM follows f WHERE f.followee_id = u.id) AS follower_count,
+				(SELECT COUNT(*) FROM follows f WHERE f.follower_id = u.id) AS following_count
+			FROM users u
+		) c
+		WHERE users.id = c.id AND (
+			users.follower_count <> c.follower_count OR
+			users.following_count <> c.following_count
+		)`)
+	return result.RowsAffected, result.Error
+}
+
+// likePrefix escapes LIKE wildcards in s and appends '%' for a prefix match.
+func likePrefix(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	return replacer.Replace(strings.ToLower(s)) + "%"
+}
+
+// Search finds users whose username starts with q or resembles q, or whose
+// bio contains words resembling q. Fuzzy matching relies on pg_trgm.
+func (r *userRepositoryImpl) Search(ctx context.Context, q string, order repositories.UserSearchOrder, limit, offset int) ([]*models.User, error) {
+	prefix := likePrefix(q)
+
+	query := dbFromContext(ctx, r.db).
+		Where("lower(username) LIKE ? OR username % ? OR ? <% bio", prefix, q, q).
+		Limit(limit).
+		Offset(offset)
+
+	switch order {
+	case repositories.UserSearchOrderFollowers:
+		query = query.Order("follower_count desc, id asc")
+	default:
+		query = query.
+			Order(gorm.Expr("lower(username) LIKE ? desc", prefix)).
+			Order(gorm.Expr("GREATEST(similarity(username, ?), word_similarity(?, bio)) desc", q, q)).
+			Order("follower_count desc, id asc")
+	}
+
+	var users []*models.User
+	if err := query.Find(&users).Error; err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// AutocompleteUsernames returns users whose username starts with prefix,
+// accounts viewerID follows first, then by follower count.
+func (r *userRepositoryImpl) AutocompleteUsernames(ctx context.Context, viewerID uint, prefix string, limit int) ([]*models.User, error) {
+	var users []*models.User
+	err := dbFromContext(ctx, r.db).
+		Where("lower(username) LIKE ?", likePrefix(prefix)).
+		Order(gorm.Expr("EXISTS (SELECT 1 FROM follows WHERE follows.follower_id = ? AND follows.followee_id = users.id) desc", viewerID)).
+		Order("follower_count desc, username asc").
+		Limit(limit).
+		Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
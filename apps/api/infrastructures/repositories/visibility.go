@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+// blockedUsersSQL selects everyone the viewer blocked or was blocked by. It
+// takes the viewer ID twice.
+const blockedUsersSQL = "SELECT blocked_id FROM blocks WHERE blocker_id = ? UNION SELECT blocker_id FROM blocks WHERE blocked_id = ?"
+
+// hiddenAuthorsSQL selects everyone whose posts the viewer may not see: both
+// sides of a block, and private accounts the viewer does not follow. It
+// takes the viewer ID four times.
+const hiddenAuthorsSQL = blockedUsersSQL + " UNION SELECT id FROM users WHERE is_private AND id <> ? AND id NOT IN (SELECT followee_id FROM follows WHERE follower_id = ?)"
+
+// visibleTo hides posts by users on either side of a block with the viewer
+// in ctx and by private accounts the viewer does not follow, along with
+// reposts and quotes of their posts. Without a viewer it hides nothing.
+func visibleTo(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		viewerID, ok := repositories.ViewerFromContext(ctx)
+		if !ok {
+			return db
+		}
+		return db.
+			Where("posts.author_id NOT IN ("+hiddenAuthorsSQL+")", viewerID, viewerID, viewerID, viewerID).
+			Where("posts.repost_id IS NULL OR NOT EXISTS (SELECT 1 FROM posts embedded WHERE embedded.id = posts.repost_id AND embedded.author_id IN ("+hiddenAuthorsSQL+"))", viewerID, viewerID, viewerID, viewerID)
+	}
+}
+
+// unmutedFor hides posts by users the viewer in ctx has muted. Feeds apply
+// it on top of visibleTo; direct lookups do not, so a muted user's post can
+// still be opened.
+func unmutedFor(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		viewerID, ok := repositories.ViewerFromContext(ctx)
+		if !ok {
+			return db
+		}
+		return db.Where("posts.author_id NOT IN (SELECT muted_id FROM mutes WHERE muter_id = ?)", viewerID)
+	}
+}
+
+// fromAudibleActors drops notifications whose actor is on either side of a
+// block with recipientID or has been muted by them.
+func fromAudibleActors(recipientID uint) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Where("notifications.actor_id NOT IN ("+blockedUsersSQL+")", recipientID, recipientID).
+			Where("notifications.actor_id NOT IN (SELECT muted_id FROM mutes WHERE muter_id = ?)", recipientID)
+	}
+}
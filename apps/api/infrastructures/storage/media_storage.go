@@ -0,0 +1,40 @@
+// Package storage stores uploaded media files.
+package storage
+
+import (
+	"context"
+	"errors"
+	"os"
+)
+
+var ErrObjectNotFound = errors.New("object not found")
+
+// MediaStorage stores media files by key. Keys are slash-separated paths
+// such as "media/42/3f2c.jpg".
+type MediaStorage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+	// URL is the public URL clients use to fetch key
+	URL(key string) string
+}
+
+// NewFromEnv uses an S3-compatible bucket when MEDIA_STORAGE=s3 and the
+// local filesystem under localDir, served at localBaseURL, otherwise.
+func NewFromEnv(localDir, localBaseURL string) (MediaStorage, error) {
+	if os.Getenv("MEDIA_STORAGE") == "s3" {
+		return NewS3Storage(S3Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Region:          os.Getenv("S3_REGION"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			PublicBaseURL:   os.Getenv("S3_PUBLIC_BASE_URL"),
+		}), nil
+	}
+	local, err := NewLocalStorage(localDir, localBaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return local, nil
+}
@@ -0,0 +1,120 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const eventsChannel = "stream:events"
+
+// Event types pushed to clients.
+const (
+	EventNewPost    = "new_post"
+	EventLikeCount  = "like_count"
+	EventReplyCount = "reply_count"
+)
+
+// Event is a real-time update about a post. AuthorID is the author of the
+// post the event is about and is what subscribers filter on.
+type Event struct {
+	Type       string `json:"type"`
+	PostID     uint   `json:"post_id"`
+	AuthorID   uint   `json:"author_id"`
+	LikeCount  *int64 `json:"like_count,omitempty"`
+	ReplyCount *int64 `json:"reply_count,omitempty"`
+}
+
+type subscriber struct {
+	events chan Event
+	accept func(Event) bool
+}
+
+// EventBroker relays events between API instances over Redis pub/sub.
+// Each instance holds a single Redis subscription and fans events out to
+// its locally connected clients.
+type EventBroker struct {
+	client *redis.Client
+
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+}
+
+func NewEventBroker(client *redis.Client) *EventBroker {
+	return &EventBroker{
+		client:      client,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// Publish sends event to every connected client on every instance.
+func (b *EventBroker) Publish(ctx context.Context, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return b.client.Publish(ctx, eventsChannel, payload).Err()
+}
+
+// Subscribe registers a local client. Only events for which accept returns
+// true are delivered. The returned function must be called to unsubscribe.
+func (b *EventBroker) Subscribe(accept func(Event) bool) (<-chan Event, func()) {
+	sub := &subscriber{
+		events: make(chan Event, 16),
+		accept: accept,
+	}
+
+	b.mu.Lock()
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+
+	return sub.events, func() {
+		b.mu.Lock()
+		delete(b.subscribers, sub)
+		b.mu.Unlock()
+	}
+}
+
+// Run consumes the Redis channel until ctx is cancelled.
+func (b *EventBroker) Run(ctx context.Context) {
+	pubsub := b.client.Subscribe(ctx, eventsChannel)
+	defer pubsub.Close()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			var event Event
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				log.Printf("stream: invalid event: %v", err)
+				continue
+			}
+			b.dispatch(event)
+		}
+	}
+}
+
+func (b *EventBroker) dispatch(event Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.subscribers {
+		if !sub.accept(event) {
+			continue
+		}
+		// Drop events for clients that are not keeping up rather than
+		// blocking every other subscriber
+		select {
+		case sub.events <- event:
+		default:
+		}
+	}
+}
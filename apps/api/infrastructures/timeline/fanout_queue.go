@@ -0,0 +1,56 @@
+package timeline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const fanoutQueueKey = "timeline:fanout:queue"
+
+// FanoutJob asks the worker to push a new post into followers' timelines.
+type FanoutJob struct {
+	PostID    uint      `json:"post_id"`
+	AuthorID  uint      `json:"author_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FanoutQueue is a Redis list of pending fan-out jobs shared by every API
+// instance.
+type FanoutQueue struct {
+	client *redis.Client
+}
+
+func NewFanoutQueue(client *redis.Client) *FanoutQueue {
+	return &FanoutQueue{client: client}
+}
+
+func (q *FanoutQueue) Enqueue(ctx context.Context, job FanoutJob) error {
+	payload, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return q.client.LPush(ctx, fanoutQueueKey, payload).Err()
+}
+
+// Dequeue blocks for up to timeout waiting for the next job. It returns nil
+// without an error when the timeout elapses.
+func (q *FanoutQueue) Dequeue(ctx context.Context, timeout time.Duration) (*FanoutJob, error) {
+	result, err := q.client.BRPop(ctx, timeout, fanoutQueueKey).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// BRPOP returns the key followed by the value
+	var job FanoutJob
+	if err := json.Unmarshal([]byte(result[1]), &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
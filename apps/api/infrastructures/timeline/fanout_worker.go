@@ -0,0 +1,42 @@
+package timeline
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// FanoutWorker pulls jobs off the fan-out queue and hands them to handle.
+type FanoutWorker struct {
+	queue  *FanoutQueue
+	handle func(ctx context.Context, job FanoutJob) error
+}
+
+func NewFanoutWorker(queue *FanoutQueue, handle func(ctx context.Context, job FanoutJob) error) *FanoutWorker {
+	return &FanoutWorker{
+		queue:  queue,
+		handle: handle,
+	}
+}
+
+// Run processes jobs until ctx is cancelled.
+func (w *FanoutWorker) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		job, err := w.queue.Dequeue(ctx, 5*time.Second)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("fanout: dequeue failed: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+		if job == nil {
+			continue
+		}
+
+		if err := w.handle(ctx, *job); err != nil {
+			log.Printf("fanout: post %d failed: %v", job.PostID, err)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package timeline
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+// sentinelMember marks a timeline as warm even when it holds no posts, so
+// that an empty timeline is not mistaken for a cache miss.
+const sentinelMember = "0"
+
+// pushScript adds a post to a timeline only if the timeline is already warm,
+// then trims it to the newest ARGV[3] entries (plus the sentinel).
+var pushScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+redis.call('ZREMRANGEBYRANK', KEYS[1], 1, -(tonumber(ARGV[3]) + 1))
+return 1
+`)
+
+// TimelineCache keeps a capped sorted set of post IDs per user in Redis,
+// scored by post creation time in microseconds.
+type TimelineCache struct {
+	client     *redis.Client
+	maxEntries int64
+}
+
+func NewTimelineCache(client *redis.Client, maxEntries int64) *TimelineCache {
+	return &TimelineCache{
+		client:     client,
+		maxEntries: maxEntries,
+	}
+}
+
+// Capacity is the number of posts kept per timeline.
+func (tc *TimelineCache) Capacity() int64 {
+	return tc.maxEntries
+}
+
+func timelineKey(userID uint) string {
+	return fmt.Sprintf("timeline:%d", userID)
+}
+
+func score(entry models.TimelineEntry) float64 {
+	return float64(entry.CreatedAt.UnixMicro())
+}
+
+// Push adds entry to each user's timeline. Timelines that are not cached are
+// left alone; they are rebuilt from Postgres on their next read.
+func (tc *TimelineCache) Push(ctx context.Context, userIDs []uint, entry models.TimelineEntry) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	pipe := tc.client.Pipeline()
+	for _, userID := range userIDs {
+		pushScript.Eval(ctx, pipe, []string{timelineKey(userID)}, score(entry), entry.PostID, tc.maxEntries)
+	}
+	_, err := pipe.Exec(ctx)
+	if err == redis.Nil {
+		return nil
+	}
+	return err
+}
+
+// Fill replaces a user's timeline with entries and marks it warm.
+func (tc *TimelineCache) Fill(ctx context.Context, userID uint, entries []models.TimelineEntry) error {
+	key := timelineKey(userID)
+	members := make([]redis.Z, 0, len(entries)+1)
+	// The sentinel scores below every real post so it sorts last
+	members = append(members, redis.Z{Score: 0, Member: sentinelMember})
+	for _, entry := range entries {
+		members = append(members, redis.Z{Score: score(entry), Member: entry.PostID})
+	}
+
+	_, err := tc.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.ZAdd(ctx, key, members...)
+		pipe.ZRemRangeByRank(ctx, key, 1, -(tc.maxEntries + 1))
+		return nil
+	})
+	return err
+}
+
+// Range returns up to limit entries older than cursor, newest first.
+// ok is false when the timeline is not cached. exhausted reports that the
+// cache ran out of entries because it was trimmed, so older posts may only
+// be found in Postgres.
+func (tc *TimelineCache) Range(ctx context.Context, userID uint, cursor *models.Cursor, limit int) (entries []models.TimelineEntry, ok bool, exhausted bool, err error) {
+	key := timelineKey(userID)
+
+	card, err := tc.client.ZCard(ctx, key).Result()
+	if err != nil {
+		return nil, false, false, err
+	}
+	if card == 0 {
+		return nil, false, false, nil
+	}
+
+	max := "+inf"
+	if cursor != nil {
+		max = strconv.FormatInt(cursor.CreatedAt.UnixMicro(), 10)
+	}
+	// Over-fetch a little so ties on the cursor's timestamp can be skipped
+	members, err := tc.client.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+		Min:   "(0",
+		Max:   max,
+		Count: int64(limit) + 8,
+	}).Result()
+	if err != nil {
+		return nil, false, false, err
+	}
+
+	entries = make([]models.TimelineEntry, 0, limit)
+	for _, m := range members {
+		id, err := strconv.ParseUint(m.Member.(string), 10, 64)
+		if err != nil {
+			continue
+		}
+		if cursor != nil && int64(m.Score) == cursor.CreatedAt.UnixMicro() && uint(id) >= cursor.ID {
+			continue
+		}
+		entries = append(entries, models.TimelineEntry{PostID: uint(id), CreatedAt: time.UnixMicro(int64(m.Score))})
+		if len(entries) == limit {
+			break
+		}
+	}
+
+	exhausted = len(entries) < limit && card > tc.maxEntries
+	return entries, true, exhausted, nil
+}
+
+// Invalidate drops a user's cached timeline, for example after the set of
+// accounts they follow changes.
+func (tc *TimelineCache) Invalidate(ctx context.Context, userID uint) error {
+	return tc.client.Del(ctx, timelineKey(userID)).Err()
+}
@@ -0,0 +1,110 @@
+package trending
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+const snapshotKey = "trending:hashtags:snapshot"
+
+// HashtagTracker counts hashtag uses in per-bucket sorted sets in Redis, so
+// ranking only ever touches the buckets inside the sliding window.
+//
+// The window is split into buckets of bucketSize. A tag's score is the sum of
+// its per-bucket counts weighted by recency (newest bucket weighs most), which
+// favours tags whose usage is rising over ones that were merely popular.
+type HashtagTracker struct {
+	client      *redis.Client
+	bucketSize  time.Duration
+	buckets     int
+	snapshotTTL time.Duration
+}
+
+func NewHashtagTracker(client *redis.Client, window, bucketSize, snapshotTTL time.Duration) *HashtagTracker {
+	buckets := int(window / bucketSize)
+	if buckets < 1 {
+		buckets = 1
+	}
+	return &HashtagTracker{
+		client:      client,
+		bucketSize:  bucketSize,
+		buckets:     buckets,
+		snapshotTTL: snapshotTTL,
+	}
+}
+
+func (t *HashtagTracker) bucketKey(bucket int64) string {
+	return fmt.Sprintf("trending:hashtags:%d", bucket)
+}
+
+func (t *HashtagTracker) bucketOf(at time.Time) int64 {
+	return at.UnixNano() / int64(t.bucketSize)
+}
+
+// Record counts one use of each tag at time at.
+func (t *HashtagTracker) Record(ctx context.Context, tags []string, at time.Time) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	key := t.bucketKey(t.bucketOf(at))
+	// Keep each bucket just long enough to slide out of the window
+	ttl := t.bucketSize * time.Duration(t.buckets+1)
+
+	pipe := t.client.Pipeline()
+	for _, tag := range tags {
+		pipe.ZIncrBy(ctx, key, 1, tag)
+	}
+	pipe.Expire(ctx, key, ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Top returns up to limit tags ranked by score. The weighted union of the
+// window's buckets is cached for snapshotTTL so that frequent reads do not
+// recompute it.
+func (t *HashtagTracker) Top(ctx context.Context, limit int) ([]models.TrendingHashtag, error) {
+	exists, err := t.client.Exists(ctx, snapshotKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if exists == 0 {
+		if err := t.buildSnapshot(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	entries, err := t.client.ZRevRangeWithScores(ctx, snapshotKey, 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]models.TrendingHashtag, 0, len(entries))
+	for _, e := range entries {
+		name, _ := e.Member.(string)
+		tags = append(tags, models.TrendingHashtag{Name: name, Score: e.Score})
+	}
+	return tags, nil
+}
+
+func (t *HashtagTracker) buildSnapshot(ctx context.Context) error {
+	current := t.bucketOf(time.Now())
+	keys := make([]string, 0, t.buckets)
+	weights := make([]float64, 0, t.buckets)
+	for i := 0; i < t.buckets; i++ {
+		keys = append(keys, t.bucketKey(current-int64(i)))
+		weights = append(weights, float64(t.buckets-i))
+	}
+
+	_, err := t.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZUnionStore(ctx, snapshotKey, &redis.ZStore{Keys: keys, Weights: weights})
+		pipe.Expire(ctx, snapshotKey, t.snapshotTTL)
+		return nil
+	})
+	return err
+}
@@ -51,6 +51,5 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	// Name, Value, MaxAge, Path, Domain, Secure, HttpOnly
 	c.SetCookie("session_id", output.SessionID, 3600*24, "/", "", false, true) // Secure false for dev
 
-	res := responses.ToUserResponse(output.User)
-	c.JSON(http.StatusOK, res)
+	c.JSON(http.StatusOK, responses.ToMeResponse(output.User))
 }
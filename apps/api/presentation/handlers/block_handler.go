@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/responses"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/block"
+)
+
+type BlockHandler struct {
+	blockUserUC       *block.BlockUserUseCase
+	unblockUserUC     *block.UnblockUserUseCase
+	getBlockedUsersUC *block.GetBlockedUsersUseCase
+}
+
+func NewBlockHandler(blockUserUC *block.BlockUserUseCase, unblockUserUC *block.UnblockUserUseCase, getBlockedUsersUC *block.GetBlockedUsersUseCase) *BlockHandler {
+	return &BlockHandler{
+		blockUserUC:       blockUserUC,
+		unblockUserUC:     unblockUserUC,
+		getBlockedUsersUC: getBlockedUsersUC,
+	}
+}
+
+func (h *BlockHandler) Block(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	output, err := h.blockUserUC.Execute(c.Request.Context(), userID.(uint), c.Param("username"))
+	if err != nil {
+		respondRelationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *BlockHandler) Unblock(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	output, err := h.unblockUserUC.Execute(c.Request.Context(), userID.(uint), c.Param("username"))
+	if err != nil {
+		respondRelationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *BlockHandler) GetBlocked(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	limit, offset, err := parseOffsetPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	users, err := h.getBlockedUsersUC.Execute(c.Request.Context(), userID.(uint), limit, offset)
+	if err != nil {
+		respondRelationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToUserResponses(users))
+}
+
+// respondRelationError maps errors from block and mute use cases.
+func respondRelationError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domainErrors.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+	case errors.Is(err, domainErrors.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
@@ -1,10 +1,12 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/bookmark"
 )
 
@@ -32,6 +34,10 @@ func (h *BookmarkHandler) ToggleBookmark(c *gin.Context) {
 
 	output, err := h.toggleBookmarkUC.Execute(c.Request.Context(), userID.(uint), uint(postID))
 	if err != nil {
+		if errors.Is(err, domainErrors.ErrPostNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
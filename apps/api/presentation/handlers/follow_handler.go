@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/requests"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/responses"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/follow"
+)
+
+type FollowHandler struct {
+	followUserUC           *follow.FollowUserUseCase
+	unfollowUserUC         *follow.UnfollowUserUseCase
+	getFollowersUC         *follow.GetFollowersUseCase
+	getFollowingUC         *follow.GetFollowingUseCase
+	getFollowRequestsUC    *follow.GetFollowRequestsUseCase
+	approveFollowRequestUC *follow.ApproveFollowRequestUseCase
+	denyFollowRequestUC    *follow.DenyFollowRequestUseCase
+	updatePrivacyUC        *follow.UpdatePrivacyUseCase
+}
+
+func NewFollowHandler(followUserUC *follow.FollowUserUseCase, unfollowUserUC *follow.UnfollowUserUseCase, getFollowersUC *follow.GetFollowersUseCase, getFollowingUC *follow.GetFollowingUseCase, getFollowRequestsUC *follow.GetFollowRequestsUseCase, approveFollowRequestUC *follow.ApproveFollowRequestUseCase, denyFollowRequestUC *follow.DenyFollowRequestUseCase, updatePrivacyUC *follow.UpdatePrivacyUseCase) *FollowHandler {
+	return &FollowHandler{
+		followUserUC:           followUserUC,
+		unfollowUserUC:         unfollowUserUC,
+		getFollowersUC:         getFollowersUC,
+		getFollowingUC:         getFollowingUC,
+		getFollowRequestsUC:    getFollowRequestsUC,
+		approveFollowRequestUC: approveFollowRequestUC,
+		denyFollowRequestUC:    denyFollowRequestUC,
+		updatePrivacyUC:        updatePrivacyUC,
+	}
+}
+
+func (h *FollowHandler) Follow(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	output, err := h.followUserUC.Execute(c.Request.Context(), userID.(uint), c.Param("username"))
+	if err != nil {
+		respondFollowError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *FollowHandler) Unfollow(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	output, err := h.unfollowUserUC.Execute(c.Request.Context(), userID.(uint), c.Param("username"))
+	if err != nil {
+		respondFollowError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *FollowHandler) GetFollowers(c *gin.Context) {
+	limit, offset, err := parseOffsetPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	users, err := h.getFollowersUC.Execute(c.Request.Context(), c.Param("username"), limit, offset)
+	if err != nil {
+		respondFollowError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToUserResponses(users))
+}
+
+func (h *FollowHandler) GetFollowing(c *gin.Context) {
+	limit, offset, err := parseOffsetPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	users, err := h.getFollowingUC.Execute(c.Request.Context(), c.Param("username"), limit, offset)
+	if err != nil {
+		respondFollowError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToUserResponses(users))
+}
+
+func (h *FollowHandler) GetFollowRequests(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	limit, offset, err := parseOffsetPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	users, err := h.getFollowRequestsUC.Execute(c.Request.Context(), userID.(uint), limit, offset)
+	if err != nil {
+		respondFollowError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToUserResponses(users))
+}
+
+func (h *FollowHandler) ApproveFollowRequest(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	if err := h.approveFollowRequestUC.Execute(c.Request.Context(), userID.(uint), c.Param("username")); err != nil {
+		respondFollowError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *FollowHandler) DenyFollowRequest(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	if err := h.denyFollowRequestUC.Execute(c.Request.Context(), userID.(uint), c.Param("username")); err != nil {
+		respondFollowError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *FollowHandler) UpdatePrivacy(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req requests.UpdatePrivacyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.updatePrivacyUC.Execute(c.Request.Context(), userID.(uint), *req.IsPrivate); err != nil {
+		respondFollowError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"is_private": *req.IsPrivate})
+}
+
+func respondFollowError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domainErrors.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+	case errors.Is(err, domainErrors.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, domainErrors.ErrFollowRequestNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Follow request not found"})
+	case errors.Is(err, domainErrors.ErrBlocked):
+		c.JSON(http.StatusForbidden, gin.H{"error": "You cannot follow this user"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
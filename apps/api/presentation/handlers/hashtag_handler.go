@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/responses"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/hashtag"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/post"
+)
+
+type HashtagHandler struct {
+	getHashtagPostsUC     *post.GetHashtagPostsUseCase
+	getTrendingHashtagsUC *hashtag.GetTrendingHashtagsUseCase
+}
+
+func NewHashtagHandler(getHashtagPostsUC *post.GetHashtagPostsUseCase, getTrendingHashtagsUC *hashtag.GetTrendingHashtagsUseCase) *HashtagHandler {
+	return &HashtagHandler{
+		getHashtagPostsUC:     getHashtagPostsUC,
+		getTrendingHashtagsUC: getTrendingHashtagsUC,
+	}
+}
+
+func (h *HashtagHandler) GetPosts(c *gin.Context) {
+	limit, cursor, err := parseCursorPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	output, err := h.getHashtagPostsUC.Execute(c.Request.Context(), userID.(uint), c.Param("tag"), limit, cursor)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidInput) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid hashtag"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToPostPageResponse(output.Posts, output.NextCursor))
+}
+
+func (h *HashtagHandler) GetTrending(c *gin.Context) {
+	limit := 10
+	if l := c.Query("limit"); l != "" {
+		if val, err := strconv.Atoi(l); err == nil && val > 0 && val <= 50 {
+			limit = val
+		}
+	}
+
+	tags, err := h.getTrendingHashtagsUC.Execute(c.Request.Context(), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToTrendingHashtagResponses(tags))
+}
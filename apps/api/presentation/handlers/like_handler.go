@@ -1,19 +1,29 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/responses"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/like"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/post"
 )
 
 type LikeHandler struct {
-	toggleLikeUC *like.ToggleLikeUseCase
+	toggleLikeUC    *like.ToggleLikeUseCase
+	getLikersUC     *like.GetLikersUseCase
+	getLikedPostsUC *post.GetLikedPostsUseCase
 }
 
-func NewLikeHandler(toggleLikeUC *like.ToggleLikeUseCase) *LikeHandler {
-	return &LikeHandler{toggleLikeUC: toggleLikeUC}
+func NewLikeHandler(toggleLikeUC *like.ToggleLikeUseCase, getLikersUC *like.GetLikersUseCase, getLikedPostsUC *post.GetLikedPostsUseCase) *LikeHandler {
+	return &LikeHandler{
+		toggleLikeUC:    toggleLikeUC,
+		getLikersUC:     getLikersUC,
+		getLikedPostsUC: getLikedPostsUC,
+	}
 }
 
 func (h *LikeHandler) ToggleLike(c *gin.Context) {
@@ -32,9 +42,71 @@ func (h *LikeHandler) ToggleLike(c *gin.Context) {
 
 	output, err := h.toggleLikeUC.Execute(c.Request.Context(), userID.(uint), uint(postID))
 	if err != nil {
+		if errors.Is(err, domainErrors.ErrPostNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusOK, output)
 }
+
+func (h *LikeHandler) GetLikers(c *gin.Context) {
+	postID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	limit, offset, err := parseOffsetPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	users, err := h.getLikersUC.Execute(c.Request.Context(), uint(postID), userID.(uint), limit, offset)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrPostNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToUserResponses(users))
+}
+
+func (h *LikeHandler) GetLikedPosts(c *gin.Context) {
+	limit, cursor, err := parseCursorPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	output, err := h.getLikedPostsUC.Execute(c.Request.Context(), c.Param("username"), userID.(uint), limit, cursor)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToPostPageResponse(output.Posts, output.NextCursor))
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/responses"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/media"
+)
+
+// multipartOverhead leaves room for multipart boundaries and headers on top
+// of the file itself
+const multipartOverhead = 64 << 10
+
+type MediaHandler struct {
+	uploadMediaUC *media.UploadMediaUseCase
+}
+
+func NewMediaHandler(uploadMediaUC *media.UploadMediaUseCase) *MediaHandler {
+	return &MediaHandler{uploadMediaUC: uploadMediaUC}
+}
+
+func (h *MediaHandler) Upload(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	data, _, ok := readUploadedFile(c)
+	if !ok {
+		return
+	}
+
+	uploaded, err := h.uploadMediaUC.Execute(c.Request.Context(), userID.(uint), data)
+	if err != nil {
+		respondMediaError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, responses.ToMediaResponse(uploaded))
+}
+
+// readUploadedFile reads the multipart "file" field, capped at
+// models.MaxMediaSize. It writes the error response itself and reports false
+// if there is no usable file.
+func readUploadedFile(c *gin.Context) (data []byte, filename string, ok bool) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, models.MaxMediaSize+multipartOverhead)
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "File too large"})
+			return nil, "", false
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A file field is required"})
+		return nil, "", false
+	}
+	defer file.Close()
+
+	if header.Size > models.MaxMediaSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "File too large"})
+		return nil, "", false
+	}
+	data, err = io.ReadAll(io.LimitReader(file, models.MaxMediaSize+1))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read file"})
+		return nil, "", false
+	}
+	return data, header.Filename, true
+}
+
+func respondMediaError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domainErrors.ErrMediaTooLarge):
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "File too large"})
+	case errors.Is(err, domainErrors.ErrUnsupportedMedia):
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Only JPEG, PNG and GIF images are supported"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
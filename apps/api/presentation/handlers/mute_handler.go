@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/requests"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/responses"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/mute"
+)
+
+type MuteHandler struct {
+	muteUserUC        *mute.MuteUserUseCase
+	unmuteUserUC      *mute.UnmuteUserUseCase
+	getMutedUsersUC   *mute.GetMutedUsersUseCase
+	createMutedWordUC *mute.CreateMutedWordUseCase
+	getMutedWordsUC   *mute.GetMutedWordsUseCase
+	updateMutedWordUC *mute.UpdateMutedWordUseCase
+	deleteMutedWordUC *mute.DeleteMutedWordUseCase
+}
+
+func NewMuteHandler(muteUserUC *mute.MuteUserUseCase, unmuteUserUC *mute.UnmuteUserUseCase, getMutedUsersUC *mute.GetMutedUsersUseCase, createMutedWordUC *mute.CreateMutedWordUseCase, getMutedWordsUC *mute.GetMutedWordsUseCase, updateMutedWordUC *mute.UpdateMutedWordUseCase, deleteMutedWordUC *mute.DeleteMutedWordUseCase) *MuteHandler {
+	return &MuteHandler{
+		muteUserUC:        muteUserUC,
+		unmuteUserUC:      unmuteUserUC,
+		getMutedUsersUC:   getMutedUsersUC,
+		createMutedWordUC: createMutedWordUC,
+		getMutedWordsUC:   getMutedWordsUC,
+		updateMutedWordUC: updateMutedWordUC,
+		deleteMutedWordUC: deleteMutedWordUC,
+	}
+}
+
+func (h *MuteHandler) Mute(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	output, err := h.muteUserUC.Execute(c.Request.Context(), userID.(uint), c.Param("username"))
+	if err != nil {
+		respondRelationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MuteHandler) Unmute(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	output, err := h.unmuteUserUC.Execute(c.Request.Context(), userID.(uint), c.Param("username"))
+	if err != nil {
+		respondRelationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MuteHandler) GetMuted(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	limit, offset, err := parseOffsetPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	users, err := h.getMutedUsersUC.Execute(c.Request.Context(), userID.(uint), limit, offset)
+	if err != nil {
+		respondRelationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToUserResponses(users))
+}
+
+func (h *MuteHandler) CreateMutedWord(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req requests.CreateMutedWordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	word, err := h.createMutedWordUC.Execute(c.Request.Context(), mute.CreateMutedWordInput{
+		UserID:          userID.(uint),
+		Word:            req.Word,
+		NonFollowedOnly: req.NonFollowedOnly,
+		ExpiresIn:       time.Duration(req.ExpiresIn) * time.Second,
+	})
+	if err != nil {
+		respondMutedWordError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, responses.ToMutedWordResponse(word))
+}
+
+func (h *MuteHandler) GetMutedWords(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	words, err := h.getMutedWordsUC.Execute(c.Request.Context(), userID.(uint))
+	if err != nil {
+		respondMutedWordError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToMutedWordResponses(words))
+}
+
+func (h *MuteHandler) UpdateMutedWord(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid muted word ID"})
+		return
+	}
+
+	var req requests.UpdateMutedWordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := mute.UpdateMutedWordInput{
+		UserID:          userID.(uint),
+		ID:              uint(id),
+		NonFollowedOnly: req.NonFollowedOnly,
+	}
+	if req.ExpiresIn != nil {
+		expiresIn := time.Duration(*req.ExpiresIn) * time.Second
+		input.ExpiresIn = &expiresIn
+	}
+
+	word, err := h.updateMutedWordUC.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondMutedWordError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToMutedWordResponse(word))
+}
+
+func (h *MuteHandler) DeleteMutedWord(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid muted word ID"})
+		return
+	}
+
+	if err := h.deleteMutedWordUC.Execute(c.Request.Context(), userID.(uint), uint(id)); err != nil {
+		respondMutedWordError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func respondMutedWordError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domainErrors.ErrMutedWordNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Muted word not found"})
+	case errors.Is(err, domainErrors.ErrMutedWordExists):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, domainErrors.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
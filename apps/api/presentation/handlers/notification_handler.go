@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/responses"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/notification"
+)
+
+type NotificationHandler struct {
+	getNotificationsUC      *notification.GetNotificationsUseCase
+	getUnreadCountUC        *notification.GetUnreadCountUseCase
+	markNotificationsReadUC *notification.MarkNotificationsReadUseCase
+}
+
+func NewNotificationHandler(getNotificationsUC *notification.GetNotificationsUseCase, getUnreadCountUC *notification.GetUnreadCountUseCase, markNotificationsReadUC *notification.MarkNotificationsReadUseCase) *NotificationHandler {
+	return &NotificationHandler{
+		getNotificationsUC:      getNotificationsUC,
+		getUnreadCountUC:        getUnreadCountUC,
+		markNotificationsReadUC: markNotificationsReadUC,
+	}
+}
+
+func (h *NotificationHandler) GetNotifications(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	limit, offset, err := parseOffsetPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.getNotificationsUC.Execute(c.Request.Context(), userID.(uint), limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToNotificationListResponse(output.Groups, output.UnreadCount))
+}
+
+func (h *NotificationHandler) GetUnreadCount(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	count, err := h.getUnreadCountUC.Execute(c.Request.Context(), userID.(uint))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"unread_count": count})
+}
+
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	if err := h.markNotificationsReadUC.Execute(c.Request.Context(), userID.(uint)); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"unread_count": 0})
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// parseCursorPage reads the `limit` and `cursor` query parameters used by
+// cursor-paginated list endpoints.
+func parseCursorPage(c *gin.Context) (int, *models.Cursor, error) {
+	limit := defaultPageLimit
+	if l := c.Query("limit"); l != "" {
+		if val, err := strconv.Atoi(l); err == nil && val > 0 {
+			limit = val
+		}
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+
+	token := c.Query("cursor")
+	if token == "" {
+		return limit, nil, nil
+	}
+	cursor, err := models.DecodeCursor(token)
+	if err != nil {
+		return 0, nil, err
+	}
+	return limit, cursor, nil
+}
+
+// parseOffsetPage reads the `limit` and `offset` query parameters used by
+// offset-paginated list endpoints. The limit is clamped like
+// parseCursorPage's; a negative or malformed offset is rejected.
+func parseOffsetPage(c *gin.Context) (int, int, error) {
+	limit := defaultPageLimit
+	if l := c.Query("limit"); l != "" {
+		if val, err := strconv.Atoi(l); err == nil && val > 0 {
+			limit = val
+		}
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+
+	offset := 0
+	if o := c.Query("offset"); o != "" {
+		val, err := strconv.Atoi(o)
+		if err != nil || val < 0 {
+			return 0, 0, errors.New("invalid offset")
+		}
+		offset = val
+	}
+	return limit, offset, nil
+}
@@ -1,7 +1,6 @@
 package handlers
 
 import (
-	"fmt"
 	"net/http"
 	"strconv"
 
@@ -9,6 +8,7 @@ import (
 	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/requests"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/responses"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/post"
 )
 
@@ -19,16 +19,28 @@ type PostHandler struct {
 	deletePostUC      *post.DeletePostUseCase
 	getPostDetailUC   *post.GetPostDetailUseCase
 	getRepliesUC      *post.GetRepliesUseCase
+	getMentionsUC     *post.GetMentionsUseCase
+	editPostUC        *post.EditPostUseCase
+	getRevisionsUC    *post.GetPostRevisionsUseCase
+	getConversationUC *post.GetConversationUseCase
+	getQuotesUC       *post.GetQuotesUseCase
+	undoRepostUC      *post.UndoRepostUseCase
 }
 
-func NewPostHandler(createPostUC *post.CreatePostUseCase, getTimelineUC *post.GetTimelineUseCase, getBookmarksUC *post.GetBookmarksUseCase, deletePostUC *post.DeletePostUseCase, getPostDetailUC *post.GetPostDetailUseCase, getRepliesUC *post.GetRepliesUseCase) *PostHandler {
+func NewPostHandler(createPostUC *post.CreatePostUseCase, getTimelineUC *post.GetTimelineUseCase, getBookmarksUC *post.GetBookmarksUseCase, deletePostUC *post.DeletePostUseCase, getPostDetailUC *post.GetPostDetailUseCase, getRepliesUC *post.GetRepliesUseCase, getMentionsUC *post.GetMentionsUseCase, editPostUC *post.EditPostUseCase, getRevisionsUC *post.GetPostRevisionsUseCase, getConversationUC *post.GetConversationUseCase, getQuotesUC *post.GetQuotesUseCase, undoRepostUC *post.UndoRepostUseCase) *PostHandler {
 	return &PostHandler{
-		createPostUC:    createPostUC,
-		getTimelineUC:   getTimelineUC,
-		getBookmarksUC:  getBookmarksUC,
-		deletePostUC:    deletePostUC,
-		getPostDetailUC: getPostDetailUC,
-		getRepliesUC:    getRepliesUC,
+		createPostUC:      createPostUC,
+		getTimelineUC:     getTimelineUC,
+		getBookmarksUC:    getBookmarksUC,
+		deletePostUC:      deletePostUC,
+		getPostDetailUC:   getPostDetailUC,
+		getRepliesUC:      getRepliesUC,
+		getMentionsUC:     getMentionsUC,
+		editPostUC:        editPostUC,
+		getRevisionsUC:    getRevisionsUC,
+		getConversationUC: getConversationUC,
+		getQuotesUC:       getQuotesUC,
+		undoRepostUC:      undoRepostUC,
 	}
 }
 
@@ -38,7 +50,6 @@ func (h *PostHandler) CreatePost(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	fmt.Printf("CreatePost Request: %+v\n", req)
 
 	userID, exists := c.Get("userID")
 	if !exists {
@@ -47,10 +58,12 @@ func (h *PostHandler) CreatePost(c *gin.Context) {
 	}
 
 	input := post.CreatePostInput{
-		Content:  req.Content,
-		AuthorID: userID.(uint),
-		ParentID: req.ParentID,
-		RepostID: req.RepostID,
+		Content:     req.Content,
+		AuthorID:    userID.(uint),
+		ParentID:    req.ParentID,
+		RepostID:    req.RepostID,
+		MediaIDs:    req.MediaIDs,
+		ReplyPolicy: req.ReplyPolicy,
 	}
 
 	output, err := h.createPostUC.Execute(c.Request.Context(), input)
@@ -58,6 +71,12 @@ func (h *PostHandler) CreatePost(c *gin.Context) {
 		switch err {
 		case domainErrors.ErrInvalidInput:
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case domainErrors.ErrPostNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Referenced post not found"})
+		case domainErrors.ErrAlreadyReposted:
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		case domainErrors.ErrProtectedPost, domainErrors.ErrReplyNotAllowed:
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		}
@@ -68,17 +87,10 @@ func (h *PostHandler) CreatePost(c *gin.Context) {
 }
 
 func (h *PostHandler) GetTimeline(c *gin.Context) {
-	limit := 20
-	offset := 0
-	if l := c.Query("limit"); l != "" {
-		if val, err := strconv.Atoi(l); err == nil {
-			limit = val
-		}
-	}
-	if o := c.Query("offset"); o != "" {
-		if val, err := strconv.Atoi(o); err == nil {
-			offset = val
-		}
+	limit, cursor, err := parseCursorPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
 	}
 
 	var targetUserID *uint
@@ -97,7 +109,7 @@ func (h *PostHandler) GetTimeline(c *gin.Context) {
 
 	input := post.GetTimelineInput{
 		Limit:        limit,
-		Offset:       offset,
+		Cursor:       cursor,
 		UserID:       userID.(uint),
 		TargetUserID: targetUserID,
 	}
@@ -108,44 +120,14 @@ func (h *PostHandler) GetTimeline(c *gin.Context) {
 		return
 	}
 
-	var response []responses.PostResponse
-	for _, p := range output.Posts {
-		// Main post info
-		likeCount := output.LikeCounts[p.ID]
-		isLiked := output.LikeMap[p.ID]
-		bookmarkCount := output.BookmarkCounts[p.ID]
-		isBookmarked := output.BookmarkMap[p.ID]
-		
-		res := responses.ToPostResponse(p, likeCount, isLiked, bookmarkCount, isBookmarked)
-
-		// Update Repost info if exists
-		if p.Repost != nil {
-			repostLikeCount := output.LikeCounts[p.Repost.ID]
-			repostIsLiked := output.LikeMap[p.Repost.ID]
-			repostBookmarkCount := output.BookmarkCounts[p.Repost.ID]
-			repostIsBookmarked := output.BookmarkMap[p.Repost.ID]
-			repostRes := responses.ToPostResponse(p.Repost, repostLikeCount, repostIsLiked, repostBookmarkCount, repostIsBookmarked)
-			res.Repost = &repostRes
-		}
-
-		response = append(response, res)
-	}
-
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, responses.ToPostPageResponse(output.Posts, output.NextCursor))
 }
 
 func (h *PostHandler) GetBookmarks(c *gin.Context) {
-	limit := 20
-	offset := 0
-	if l := c.Query("limit"); l != "" {
-		if val, err := strconv.Atoi(l); err == nil {
-			limit = val
-		}
-	}
-	if o := c.Query("offset"); o != "" {
-		if val, err := strconv.Atoi(o); err == nil {
-			offset = val
-		}
+	limit, cursor, err := parseCursorPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
 	}
 
 	userID, exists := c.Get("userID")
@@ -154,23 +136,35 @@ func (h *PostHandler) GetBookmarks(c *gin.Context) {
 		return
 	}
 
-	output, err := h.getBookmarksUC.Execute(c.Request.Context(), userID.(uint), limit, offset)
+	output, err := h.getBookmarksUC.Execute(c.Request.Context(), userID.(uint), limit, cursor)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	var response []responses.PostResponse
-	for _, p := range output {
-		res := responses.ToPostResponse(p, 0, p.IsLiked, 0, p.IsBookmarked) // Counts are missing!
-		if p.Repost != nil {
-			repostRes := responses.ToPostResponse(p.Repost, 0, p.Repost.IsLiked, 0, p.Repost.IsBookmarked)
-			res.Repost = &repostRes
-		}
-		response = append(response, res)
+	c.JSON(http.StatusOK, responses.ToPostPageResponse(output.Posts, output.NextCursor))
+}
+
+func (h *PostHandler) GetMentions(c *gin.Context) {
+	limit, cursor, err := parseCursorPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	output, err := h.getMentionsUC.Execute(c.Request.Context(), userID.(uint), limit, cursor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, responses.ToPostPageResponse(output.Posts, output.NextCursor))
 }
 
 func (h *PostHandler) DeletePost(c *gin.Context) {
@@ -191,6 +185,8 @@ func (h *PostHandler) DeletePost(c *gin.Context) {
 		switch err {
 		case domainErrors.ErrUnauthorized:
 			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case domainErrors.ErrPostNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		}
@@ -200,6 +196,132 @@ func (h *PostHandler) DeletePost(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+func (h *PostHandler) UndoRepost(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	if err := h.undoRepostUC.Execute(c.Request.Context(), uint(id), userID.(uint)); err != nil {
+		if err == domainErrors.ErrPostNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Repost not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *PostHandler) GetQuotes(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	limit, cursor, err := parseCursorPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	output, err := h.getQuotesUC.Execute(c.Request.Context(), uint(id), userID.(uint), limit, cursor)
+	if err != nil {
+		if err == domainErrors.ErrPostNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToPostPageResponse(output.Posts, output.NextCursor))
+}
+
+func (h *PostHandler) EditPost(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	var req requests.EditPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	input := post.EditPostInput{
+		PostID:  uint(id),
+		UserID:  userID.(uint),
+		Content: req.Content,
+	}
+
+	edited, err := h.editPostUC.Execute(c.Request.Context(), input)
+	if err != nil {
+		switch err {
+		case domainErrors.ErrInvalidInput:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case domainErrors.ErrUnauthorized, domainErrors.ErrEditWindowExpired:
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case domainErrors.ErrPostNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToPostResponse(edited, edited.LikeCount, edited.IsLiked, edited.BookmarkCount, edited.IsBookmarked))
+}
+
+func (h *PostHandler) GetRevisions(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	revisions, err := h.getRevisionsUC.Execute(c.Request.Context(), uint(id), userID.(uint))
+	if err != nil {
+		if err == domainErrors.ErrPostNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToPostRevisionResponses(revisions))
+}
+
 func (h *PostHandler) GetPostDetail(c *gin.Context) {
 	postID, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
@@ -222,6 +344,50 @@ func (h *PostHandler) GetPostDetail(c *gin.Context) {
 	c.JSON(http.StatusOK, responses.ToPostResponse(post, post.LikeCount, post.IsLiked, post.BookmarkCount, post.IsBookmarked))
 }
 
+// defaultConversationDepth is how many reply levels GetConversation loads
+// when the request does not say.
+const defaultConversationDepth = 3
+
+func (h *PostHandler) GetConversation(c *gin.Context) {
+	postID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	depth := defaultConversationDepth
+	if d := c.Query("depth"); d != "" {
+		if val, err := strconv.Atoi(d); err == nil && val > 0 {
+			depth = min(val, post.MaxConversationDepth)
+		}
+	}
+
+	input := post.GetConversationInput{
+		PostID: uint(postID),
+		UserID: userID.(uint),
+		Depth:  depth,
+		Order:  repositories.ReplyOrder(c.Query("sort")),
+	}
+
+	conversation, err := h.getConversationUC.Execute(c.Request.Context(), input)
+	if err != nil {
+		if err == domainErrors.ErrPostNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToConversationResponse(conversation))
+}
+
 func (h *PostHandler) GetReplies(c *gin.Context) {
 	postID, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
@@ -235,16 +401,21 @@ func (h *PostHandler) GetReplies(c *gin.Context) {
 		return
 	}
 
-	replies, err := h.getRepliesUC.Execute(c.Request.Context(), uint(postID), userID.(uint))
+	limit, cursor, err := parseCursorPage(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	var responseList []responses.PostResponse
-	for _, reply := range replies {
-		responseList = append(responseList, responses.ToPostResponse(reply, reply.LikeCount, reply.IsLiked, reply.BookmarkCount, reply.IsBookmarked))
+	output, err := h.getRepliesUC.Execute(c.Request.Context(), uint(postID), userID.(uint), limit, cursor)
+	if err != nil {
+		if err == domainErrors.ErrPostNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
 	}
 
-	c.JSON(http.StatusOK, responseList)
+	c.JSON(http.StatusOK, responses.ToPostPageResponse(output.Posts, output.NextCursor))
 }
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/responses"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/post"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/user"
+)
+
+type SearchHandler struct {
+	searchPostsUC       *post.SearchPostsUseCase
+	searchUsersUC       *user.SearchUsersUseCase
+	autocompleteUsersUC *user.AutocompleteUsersUseCase
+}
+
+func NewSearchHandler(searchPostsUC *post.SearchPostsUseCase, searchUsersUC *user.SearchUsersUseCase, autocompleteUsersUC *user.AutocompleteUsersUseCase) *SearchHandler {
+	return &SearchHandler{
+		searchPostsUC:       searchPostsUC,
+		searchUsersUC:       searchUsersUC,
+		autocompleteUsersUC: autocompleteUsersUC,
+	}
+}
+
+func (h *SearchHandler) SearchPosts(c *gin.Context) {
+	limit, cursor, err := parseCursorPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	input := post.SearchPostsInput{
+		Query:  c.Query("q"),
+		UserID: userID.(uint),
+		Limit:  limit,
+		Cursor: cursor,
+	}
+
+	output, err := h.searchPostsUC.Execute(c.Request.Context(), input)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidInput) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToPostPageResponse(output.Posts, output.NextCursor))
+}
+
+func (h *SearchHandler) SearchUsers(c *gin.Context) {
+	limit, offset, err := parseOffsetPage(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := user.SearchUsersInput{
+		Query:  c.Query("q"),
+		Order:  repositories.UserSearchOrder(c.Query("sort")),
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	users, err := h.searchUsersUC.Execute(c.Request.Context(), input)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidInput) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Query is required"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToUserSearchResponses(users))
+}
+
+func (h *SearchHandler) AutocompleteUsers(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	users, err := h.autocompleteUsersUC.Execute(c.Request.Context(), userID.(uint), c.Query("q"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToUserSuggestionResponses(users))
+}
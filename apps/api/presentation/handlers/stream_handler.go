@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/stream"
+)
+
+const streamHeartbeatInterval = 30 * time.Second
+
+type StreamHandler struct {
+	subscribeEventsUC *stream.SubscribeEventsUseCase
+}
+
+func NewStreamHandler(subscribeEventsUC *stream.SubscribeEventsUseCase) *StreamHandler {
+	return &StreamHandler{subscribeEventsUC: subscribeEventsUC}
+}
+
+// Stream pushes timeline events to the client as Server-Sent Events until
+// the client disconnects.
+func (h *StreamHandler) Stream(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	events, unsubscribe, err := h.subscribeEventsUC.Execute(c.Request.Context(), userID.(uint))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer unsubscribe()
+
+	// Disable proxy buffering so events are delivered immediately
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case event := <-events:
+			c.SSEvent(event.Type, event)
+			return true
+		case <-heartbeat.C:
+			c.SSEvent("ping", gin.H{"time": time.Now().Unix()})
+			return true
+		}
+	})
+}
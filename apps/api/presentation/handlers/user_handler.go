@@ -7,22 +7,27 @@ import (
 	"github.com/gin-gonic/gin"
 
 	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/requests"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/responses"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/user"
 )
 
 type UserHandler struct {
-	createUserUC     *user.CreateUserUseCase
-	getUserProfileUC *user.GetUserProfileUseCase
-	getMeUC          *user.GetMeUseCase
+	createUserUC         *user.CreateUserUseCase
+	getUserProfileUC     *user.GetUserProfileUseCase
+	getMeUC              *user.GetMeUseCase
+	updateProfileUC      *user.UpdateProfileUseCase
+	uploadProfileImageUC *user.UploadProfileImageUseCase
 }
 
-func NewUserHandler(createUserUC *user.CreateUserUseCase, getUserProfileUC *user.GetUserProfileUseCase, getMeUC *user.GetMeUseCase) *UserHandler {
+func NewUserHandler(createUserUC *user.CreateUserUseCase, getUserProfileUC *user.GetUserProfileUseCase, getMeUC *user.GetMeUseCase, updateProfileUC *user.UpdateProfileUseCase, uploadProfileImageUC *user.UploadProfileImageUseCase) *UserHandler {
 	return &UserHandler{
-		createUserUC:     createUserUC,
-		getUserProfileUC: getUserProfileUC,
-		getMeUC:          getMeUC,
+		createUserUC:         createUserUC,
+		getUserProfileUC:     getUserProfileUC,
+		getMeUC:              getMeUC,
+		updateProfileUC:      updateProfileUC,
+		uploadProfileImageUC: uploadProfileImageUC,
 	}
 }
 
@@ -53,12 +58,18 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, responses.ToUserResponse(output.User)) // Simplified response
+	c.JSON(http.StatusCreated, responses.ToMeResponse(output.User))
 }
 
 func (h *UserHandler) GetProfile(c *gin.Context) { // Added new method
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
 	username := c.Param("username")
-	user, err := h.getUserProfileUC.Execute(c.Request.Context(), username)
+	output, err := h.getUserProfileUC.Execute(c.Request.Context(), username, userID.(uint))
 	if err != nil {
 		if errors.Is(err, domainErrors.ErrUserNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
@@ -68,7 +79,7 @@ func (h *UserHandler) GetProfile(c *gin.Context) { // Added new method
 		return
 	}
 
-	c.JSON(http.StatusOK, responses.ToUserResponse(user))
+	c.JSON(http.StatusOK, responses.ToUserProfileResponse(output.User, output.FollowerCount, output.FollowingCount, output.PostCount, output.IsFollowedByMe, output.FollowsMe, output.IsRequested, output.ShowBirthday))
 }
 
 func (h *UserHandler) GetMe(c *gin.Context) {
@@ -88,5 +99,77 @@ func (h *UserHandler) GetMe(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, responses.ToUserResponse(user))
+	c.JSON(http.StatusOK, responses.ToMeResponse(user))
+}
+
+func (h *UserHandler) UpdateMe(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req requests.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	update := models.ProfileUpdate{
+		DisplayName:        req.DisplayName,
+		Bio:                req.Bio,
+		Location:           req.Location,
+		Website:            req.Website,
+		Birthday:           req.Birthday,
+		BirthdayVisibility: req.BirthdayVisibility,
+	}
+	updated, err := h.updateProfileUC.Execute(c.Request.Context(), userID.(uint), update)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrUserNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToMeResponse(updated))
+}
+
+func (h *UserHandler) UploadAvatar(c *gin.Context) {
+	h.uploadProfileImage(c, models.ProfileImageAvatar)
+}
+
+func (h *UserHandler) UploadBanner(c *gin.Context) {
+	h.uploadProfileImage(c, models.ProfileImageBanner)
+}
+
+// uploadProfileImage reads the uploaded file the same way media uploads do
+// and stores it as the caller's avatar or banner.
+func (h *UserHandler) uploadProfileImage(c *gin.Context, kind models.ProfileImage) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	data, _, ok := readUploadedFile(c)
+	if !ok {
+		return
+	}
+
+	updated, err := h.uploadProfileImageUC.Execute(c.Request.Context(), userID.(uint), kind, data)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		respondMediaError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ToMeResponse(updated))
 }
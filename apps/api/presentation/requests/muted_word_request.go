@@ -0,0 +1,14 @@
+package requests
+
+// ExpiresIn is a duration in seconds; 0 or omitted mutes indefinitely.
+type CreateMutedWordRequest struct {
+	Word            string `json:"word" binding:"required,max=100"`
+	NonFollowedOnly bool   `json:"non_followed_only"`
+	ExpiresIn       int64  `json:"expires_in" binding:"min=0"`
+}
+
+// Omitted fields are left unchanged. ExpiresIn restarts the mute from now.
+type UpdateMutedWordRequest struct {
+	NonFollowedOnly *bool  `json:"non_followed_only"`
+	ExpiresIn       *int64 `json:"expires_in" binding:"omitempty,min=0"`
+}
@@ -1,7 +1,13 @@
 package requests
 
 type CreatePostRequest struct {
-	Content  string `json:"content" binding:"max=140"`
-	ParentID *uint  `json:"parent_id"`
-	RepostID *uint  `json:"repost_id"`
+	Content     string `json:"content" binding:"max=140"`
+	ParentID    *uint  `json:"parent_id"`
+	RepostID    *uint  `json:"repost_id"`
+	MediaIDs    []uint `json:"media_ids" binding:"max=4"`
+	ReplyPolicy string `json:"reply_policy" binding:"omitempty,oneof=everyone following mentioned"`
+}
+
+type EditPostRequest struct {
+	Content string `json:"content" binding:"max=140"`
 }
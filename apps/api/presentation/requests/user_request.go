@@ -5,3 +5,18 @@ type CreateUserRequest struct {
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required,min=8"`
 }
+
+type UpdatePrivacyRequest struct {
+	IsPrivate *bool `json:"is_private" binding:"required"`
+}
+
+// UpdateProfileRequest edits the caller's profile. Omitted fields are left
+// unchanged and empty strings clear a field.
+type UpdateProfileRequest struct {
+	DisplayName        *string `json:"display_name"`
+	Bio                *string `json:"bio"`
+	Location           *string `json:"location"`
+	Website            *string `json:"website"`
+	Birthday           *string `json:"birthday"`
+	BirthdayVisibility *string `json:"birthday_visibility"`
+}
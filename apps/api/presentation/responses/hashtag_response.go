@@ -0,0 +1,21 @@
+package responses
+
+import (
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+type TrendingHashtagResponse struct {
+	Name  string  `json:"name"`
+	Score float64 `json:"score"`
+}
+
+func ToTrendingHashtagResponses(tags []models.TrendingHashtag) []TrendingHashtagResponse {
+	res := make([]TrendingHashtagResponse, 0, len(tags))
+	for _, tag := range tags {
+		res = append(res, TrendingHashtagResponse{
+			Name:  tag.Name,
+			Score: tag.Score,
+		})
+	}
+	return res
+}
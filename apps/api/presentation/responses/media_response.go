@@ -0,0 +1,63 @@
+package responses
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+type MediaVariantResponse struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// MediaResponse describes an image. SrcSet lists the variants and the
+// original by width, ready for an <img srcset>; until processing finishes it
+// only holds the original.
+type MediaResponse struct {
+	ID       uint                   `json:"id"`
+	URL      string                 `json:"url"`
+	MimeType string                 `json:"mime_type"`
+	Width    int                    `json:"width"`
+	Height   int                    `json:"height"`
+	Status   string                 `json:"status"`
+	BlurHash string                 `json:"blurhash"`
+	Variants []MediaVariantResponse `json:"variants"`
+	SrcSet   string                 `json:"srcset"`
+}
+
+func ToMediaResponse(media *models.Media) MediaResponse {
+	variants := make([]MediaVariantResponse, 0, len(media.Variants))
+	srcset := make([]string, 0, len(media.Variants)+1)
+	for _, v := range media.Variants {
+		variants = append(variants, MediaVariantResponse{
+			URL:    v.URL,
+			Width:  v.Width,
+			Height: v.Height,
+		})
+		srcset = append(srcset, fmt.Sprintf("%s %dw", v.URL, v.Width))
+	}
+	srcset = append(srcset, fmt.Sprintf("%s %dw", media.URL, media.Width))
+
+	return MediaResponse{
+		ID:       media.ID,
+		URL:      media.URL,
+		MimeType: media.MimeType,
+		Width:    media.Width,
+		Height:   media.Height,
+		Status:   media.Status,
+		BlurHash: media.BlurHash,
+		Variants: variants,
+		SrcSet:   strings.Join(srcset, ", "),
+	}
+}
+
+func toMediaResponses(media []models.Media) []MediaResponse {
+	res := make([]MediaResponse, 0, len(media))
+	for i := range media {
+		res = append(res, ToMediaResponse(&media[i]))
+	}
+	return res
+}
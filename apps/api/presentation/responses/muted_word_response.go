@@ -0,0 +1,33 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+type MutedWordResponse struct {
+	ID              uint       `json:"id"`
+	Word            string     `json:"word"`
+	NonFollowedOnly bool       `json:"non_followed_only"`
+	ExpiresAt       *time.Time `json:"expires_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+func ToMutedWordResponse(word *models.MutedWord) MutedWordResponse {
+	return MutedWordResponse{
+		ID:              word.ID,
+		Word:            word.Word,
+		NonFollowedOnly: word.NonFollowedOnly,
+		ExpiresAt:       word.ExpiresAt,
+		CreatedAt:       word.CreatedAt,
+	}
+}
+
+func ToMutedWordResponses(words []*models.MutedWord) []MutedWordResponse {
+	res := make([]MutedWordResponse, 0, len(words))
+	for _, w := range words {
+		res = append(res, ToMutedWordResponse(w))
+	}
+	return res
+}
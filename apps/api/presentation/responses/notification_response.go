@@ -0,0 +1,84 @@
+package responses
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+type NotificationGroupResponse struct {
+	Type       string         `json:"type"`
+	Summary    string         `json:"summary"`
+	Actors     []UserResponse `json:"actors"`
+	ActorCount int64          `json:"actor_count"`
+	Post       *PostResponse  `json:"post,omitempty"`
+	IsRead     bool           `json:"is_read"`
+	LatestAt   time.Time      `json:"latest_at"`
+}
+
+type NotificationListResponse struct {
+	Notifications []NotificationGroupResponse `json:"notifications"`
+	UnreadCount   int64                       `json:"unread_count"`
+}
+
+var notificationActions = map[string]string{
+	models.NotificationTypeLike:    "liked your post",
+	models.NotificationTypeReply:   "replied to your post",
+	models.NotificationTypeRepost:  "reposted your post",
+	models.NotificationTypeQuote:   "quoted your post",
+	models.NotificationTypeFollow:  "followed you",
+	models.NotificationTypeMention: "mentioned you",
+}
+
+func ToNotificationGroupResponse(group *models.NotificationGroup) NotificationGroupResponse {
+	actors := make([]UserResponse, 0, len(group.Actors))
+	for i := range group.Actors {
+		actors = append(actors, ToUserResponse(&group.Actors[i]))
+	}
+
+	var post *PostResponse
+	if group.Post != nil {
+		p := ToPostResponse(group.Post, group.Post.LikeCount, group.Post.IsLiked, group.Post.BookmarkCount, group.Post.IsBookmarked)
+		post = &p
+	}
+
+	return NotificationGroupResponse{
+		Type:       group.Type,
+		Summary:    notificationSummary(group),
+		Actors:     actors,
+		ActorCount: group.ActorCount,
+		Post:       post,
+		IsRead:     group.IsRead,
+		LatestAt:   group.LatestAt,
+	}
+}
+
+func ToNotificationListResponse(groups []*models.NotificationGroup, unreadCount int64) NotificationListResponse {
+	res := make([]NotificationGroupResponse, 0, len(groups))
+	for _, group := range groups {
+		res = append(res, ToNotificationGroupResponse(group))
+	}
+	return NotificationListResponse{
+		Notifications: res,
+		UnreadCount:   unreadCount,
+	}
+}
+
+// notificationSummary renders e.g. "alice and 3 others liked your post".
+func notificationSummary(group *models.NotificationGroup) string {
+	if len(group.Actors) == 0 {
+		return ""
+	}
+
+	action := notificationActions[group.Type]
+	name := group.Actors[0].Username
+	switch others := group.ActorCount - 1; {
+	case others <= 0:
+		return fmt.Sprintf("%s %s", name, action)
+	case others == 1:
+		return fmt.Sprintf("%s and 1 other %s", name, action)
+	default:
+		return fmt.Sprintf("%s and %d others %s", name, others, action)
+	}
+}
@@ -7,23 +7,36 @@ import (
 )
 
 type PostResponse struct {
-	ID        uint          `json:"id"`
-	Content   string        `json:"content"`
-	Author    UserResponse  `json:"author"`
-	ParentID  *uint         `json:"parent_id,omitempty"`
-	RepostID  *uint         `json:"repost_id,omitempty"`
-	Repost    *PostResponse `json:"repost,omitempty"`
-	LikeCount     int64         `json:"like_count"`
-	IsLiked       bool          `json:"is_liked"`
-	BookmarkCount int64         `json:"bookmark_count"`
-	IsBookmarked  bool          `json:"is_bookmarked"`
-	ReplyCount    int64         `json:"reply_count"`
-	RepostCount   int64         `json:"repost_count"`
-	IsReposted    bool          `json:"is_reposted"`
-	CreatedAt     time.Time     `json:"created_at"`
+	ID            uint              `json:"id"`
+	Content       string            `json:"content"`
+	Kind          string            `json:"kind"`
+	ReplyPolicy   string            `json:"reply_policy"`
+	Author        UserResponse      `json:"author"`
+	ParentID      *uint             `json:"parent_id,omitempty"`
+	RepostID      *uint             `json:"repost_id,omitempty"`
+	Repost        *PostResponse     `json:"repost,omitempty"`
+	Mentions      []MentionResponse `json:"mentions"`
+	Media         []MediaResponse   `json:"media"`
+	LikeCount     int64             `json:"like_count"`
+	IsLiked       bool              `json:"is_liked"`
+	BookmarkCount int64             `json:"bookmark_count"`
+	IsBookmarked  bool              `json:"is_bookmarked"`
+	ReplyCount    int64             `json:"reply_count"`
+	RepostCount   int64             `json:"repost_count"`
+	QuoteCount    int64             `json:"quote_count"`
+	IsReposted    bool              `json:"is_reposted"`
+	CanReply      bool              `json:"can_reply"`
+	RevisionCount int64             `json:"revision_count"`
+	EditedAt      *time.Time        `json:"edited_at"`
+	IsDeleted     bool              `json:"is_deleted"`
+	CreatedAt     time.Time         `json:"created_at"`
 }
 
 func ToPostResponse(post *models.Post, likeCount int64, isLiked bool, bookmarkCount int64, isBookmarked bool) PostResponse {
+	if post.DeletedAt.Valid {
+		return toTombstoneResponse(post)
+	}
+
 	var repost *PostResponse
 	if post.Repost != nil {
 		// Recursively convert repost. Note: Repost's counts/status should be passed if available.
@@ -38,17 +51,152 @@ func ToPostResponse(post *models.Post, likeCount int64, isLiked bool, bookmarkCo
 	return PostResponse{
 		ID:            post.ID,
 		Content:       post.Content,
+		Kind:          post.Kind,
+		ReplyPolicy:   post.ReplyPolicy,
 		Author:        ToUserResponse(&post.Author),
 		ParentID:      post.ParentID,
 		RepostID:      post.RepostID,
 		Repost:        repost,
+		Mentions:      toMentionResponses(post),
+		Media:         toMediaResponses(post.Media),
 		LikeCount:     likeCount,
 		IsLiked:       isLiked,
 		BookmarkCount: bookmarkCount,
 		IsBookmarked:  isBookmarked,
 		ReplyCount:    post.ReplyCount,
 		RepostCount:   post.RepostCount,
+		QuoteCount:    post.QuoteCount,
 		IsReposted:    post.IsReposted,
+		CanReply:      post.CanReply,
+		RevisionCount: post.RevisionCount,
+		EditedAt:      post.EditedAt,
 		CreatedAt:     post.CreatedAt,
 	}
 }
+
+// toTombstoneResponse renders a deleted post as a placeholder. It keeps only
+// what a thread needs to stay connected; content, author and media are
+// withheld.
+func toTombstoneResponse(post *models.Post) PostResponse {
+	return PostResponse{
+		ID:         post.ID,
+		Kind:       post.Kind,
+		ParentID:   post.ParentID,
+		RepostID:   post.RepostID,
+		Mentions:   []MentionResponse{},
+		Media:      []MediaResponse{},
+		ReplyCount: post.ReplyCount,
+		IsDeleted:  true,
+		CreatedAt:  post.CreatedAt,
+	}
+}
+
+// MentionResponse is a resolved @username in the post content. Start and End
+// are code point offsets into Content, End exclusive, covering the '@'.
+type MentionResponse struct {
+	UserID   uint   `json:"user_id"`
+	Username string `json:"username"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+}
+
+func toMentionResponses(post *models.Post) []MentionResponse {
+	res := make([]MentionResponse, 0, len(post.Mentions))
+	if len(post.Mentions) == 0 {
+		return res
+	}
+
+	runes := []rune(post.Content)
+	for _, m := range post.Mentions {
+		var username string
+		if m.Start >= 0 && m.End <= len(runes) && m.Start < m.End {
+			username = string(runes[m.Start+1 : m.End])
+		}
+		res = append(res, MentionResponse{
+			UserID:   m.UserID,
+			Username: username,
+			Start:    m.Start,
+			End:      m.End,
+		})
+	}
+	return res
+}
+
+// PostPageResponse is the envelope for cursor-paginated post lists.
+// NextCursor is null on the last page.
+type PostPageResponse struct {
+	Posts      []PostResponse `json:"posts"`
+	NextCursor *string        `json:"next_cursor"`
+}
+
+func ToPostPageResponse(posts []*models.Post, next *models.Cursor) PostPageResponse {
+	res := PostPageResponse{Posts: make([]PostResponse, 0, len(posts))}
+	for _, p := range posts {
+		res.Posts = append(res.Posts, ToPostResponse(p, p.LikeCount, p.IsLiked, p.BookmarkCount, p.IsBookmarked))
+	}
+	if next != nil {
+		token := next.Encode()
+		res.NextCursor = &token
+	}
+	return res
+}
+
+type PostRevisionResponse struct {
+	ID        uint      `json:"id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func ToPostRevisionResponses(revisions []*models.PostRevision) []PostRevisionResponse {
+	res := make([]PostRevisionResponse, 0, len(revisions))
+	for _, r := range revisions {
+		res = append(res, PostRevisionResponse{
+			ID:        r.ID,
+			Content:   r.Content,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+	return res
+}
+
+type ConversationResponse struct {
+	Ancestors []PostResponse             `json:"ancestors"`
+	Post      PostResponse               `json:"post"`
+	Replies   []ConversationNodeResponse `json:"replies"`
+}
+
+// ConversationNodeResponse is a reply with the replies below it nested
+// inline.
+type ConversationNodeResponse struct {
+	PostResponse
+	Replies []ConversationNodeResponse `json:"replies"`
+}
+
+func ToConversationResponse(conversation *models.Conversation) ConversationResponse {
+	res := ConversationResponse{
+		Ancestors: make([]PostResponse, 0, len(conversation.Ancestors)),
+		Post:      toViewerPostResponse(conversation.Post),
+		Replies:   toConversationNodeResponses(conversation.Replies),
+	}
+	for _, p := range conversation.Ancestors {
+		res.Ancestors = append(res.Ancestors, toViewerPostResponse(p))
+	}
+	return res
+}
+
+func toConversationNodeResponses(nodes []*models.ConversationNode) []ConversationNodeResponse {
+	res := make([]ConversationNodeResponse, 0, len(nodes))
+	for _, n := range nodes {
+		res = append(res, ConversationNodeResponse{
+			PostResponse: toViewerPostResponse(n.Post),
+			Replies:      toConversationNodeResponses(n.Replies),
+		})
+	}
+	return res
+}
+
+// toViewerPostResponse converts a post whose engagement status has been
+// populated on the model.
+func toViewerPostResponse(p *models.Post) PostResponse {
+	return ToPostResponse(p, p.LikeCount, p.IsLiked, p.BookmarkCount, p.IsBookmarked)
+}
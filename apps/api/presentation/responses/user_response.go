@@ -7,21 +7,134 @@ import (
 )
 
 type UserResponse struct {
-	ID        uint      `json:"id"`
-	Username  string    `json:"username"`
-	Email     string    `json:"email"`
-	Bio       string    `json:"bio"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID          uint      `json:"id"`
+	Username    string    `json:"username"`
+	DisplayName string    `json:"display_name"`
+	Bio         string    `json:"bio"`
+	Location    string    `json:"location"`
+	Website     string    `json:"website"`
+	AvatarURL   string    `json:"avatar_url"`
+	BannerURL   string    `json:"banner_url"`
+	Birthday    *string   `json:"birthday,omitempty"`
+	IsPrivate   bool      `json:"is_private"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+// ToUserResponse renders user for anyone. The birthday is only included
+// when it is public.
 func ToUserResponse(user *models.User) UserResponse {
-	return UserResponse{
-		ID:        user.ID,
-		Username:  user.Username,
-		Email:     user.Email,
-		Bio:       user.Bio,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+	return toUserResponse(user, user.BirthdayVisibility == models.BirthdayVisibilityPublic)
+}
+
+func toUserResponse(user *models.User, showBirthday bool) UserResponse {
+	res := UserResponse{
+		ID:          user.ID,
+		Username:    user.Username,
+		DisplayName: user.DisplayName,
+		Bio:         user.Bio,
+		Location:    user.Location,
+		Website:     user.Website,
+		AvatarURL:   user.AvatarURL,
+		BannerURL:   user.BannerURL,
+		IsPrivate:   user.IsPrivate,
+		CreatedAt:   user.CreatedAt,
+		UpdatedAt:   user.UpdatedAt,
+	}
+	if showBirthday && user.Birthday != nil {
+		birthday := user.Birthday.Format("2006-01-02")
+		res.Birthday = &birthday
+	}
+	return res
+}
+
+// MeResponse is the signed-in user's own profile, including the email and
+// settings only they see.
+type MeResponse struct {
+	UserResponse
+	Email              string `json:"email"`
+	BirthdayVisibility string `json:"birthday_visibility"`
+}
+
+func ToMeResponse(user *models.User) MeResponse {
+	return MeResponse{
+		UserResponse:       toUserResponse(user, true),
+		Email:              user.Email,
+		BirthdayVisibility: user.BirthdayVisibility,
+	}
+}
+
+type UserProfileResponse struct {
+	UserResponse
+	FollowerCount  int64 `json:"follower_count"`
+	FollowingCount int64 `json:"following_count"`
+	PostCount      int64 `json:"post_count"`
+	IsFollowedByMe bool  `json:"is_followed_by_me"`
+	FollowsMe      bool  `json:"follows_me"`
+	IsRequested    bool  `json:"is_requested"`
+}
+
+func ToUserProfileResponse(user *models.User, followerCount, followingCount, postCount int64, isFollowedByMe, followsMe, isRequested, showBirthday bool) UserProfileResponse {
+	return UserProfileResponse{
+		UserResponse:   toUserResponse(user, showBirthday),
+		FollowerCount:  followerCount,
+		FollowingCount: followingCount,
+		PostCount:      postCount,
+		IsFollowedByMe: isFollowedByMe,
+		FollowsMe:      followsMe,
+		IsRequested:    isRequested,
+	}
+}
+
+func ToUserResponses(users []*models.User) []UserResponse {
+	res := make([]UserResponse, 0, len(users))
+	for _, user := range users {
+		res = append(res, ToUserResponse(user))
+	}
+	return res
+}
+
+// UserSearchResponse is the public user shape returned by search. It leaves
+// out the email and birthday.
+type UserSearchResponse struct {
+	ID            uint   `json:"id"`
+	Username      string `json:"username"`
+	DisplayName   string `json:"display_name"`
+	Bio           string `json:"bio"`
+	AvatarURL     string `json:"avatar_url"`
+	IsPrivate     bool   `json:"is_private"`
+	FollowerCount int64  `json:"follower_count"`
+}
+
+func ToUserSearchResponses(users []*models.User) []UserSearchResponse {
+	res := make([]UserSearchResponse, 0, len(users))
+	for _, user := range users {
+		res = append(res, UserSearchResponse{
+			ID:            user.ID,
+			Username:      user.Username,
+			DisplayName:   user.DisplayName,
+			Bio:           user.Bio,
+			AvatarURL:     user.AvatarURL,
+			IsPrivate:     user.IsPrivate,
+			FollowerCount: user.FollowerCount,
+		})
+	}
+	return res
+}
+
+// UserSuggestionResponse is the minimal user shape for autocomplete.
+type UserSuggestionResponse struct {
+	ID       uint   `json:"id"`
+	Username string `json:"username"`
+}
+
+func ToUserSuggestionResponses(users []*models.User) []UserSuggestionResponse {
+	res := make([]UserSuggestionResponse, 0, len(users))
+	for _, user := range users {
+		res = append(res, UserSuggestionResponse{
+			ID:       user.ID,
+			Username: user.Username,
+		})
 	}
+	return res
 }
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+type BlockRepository interface {
+	Create(ctx context.Context, block *models.Block) error
+	Delete(ctx context.Context, blockerID, blockedID uint) error
+	Exists(ctx context.Context, blockerID, blockedID uint) (bool, error)
+	// ExistsBetween reports whether either user has blocked the other
+	ExistsBetween(ctx context.Context, userID, otherID uint) (bool, error)
+	ListBlocked(ctx context.Context, blockerID uint, limit, offset int) ([]*models.User, error)
+}
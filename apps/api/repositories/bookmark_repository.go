@@ -7,8 +7,10 @@ import (
 )
 
 type BookmarkRepository interface {
-	Create(ctx context.Context, bookmark *models.Bookmark) error
-	Delete(ctx context.Context, userID, postID uint) error
+	// Create records bookmark and reports whether it did not exist yet
+	Create(ctx context.Context, bookmark *models.Bookmark) (bool, error)
+	// Delete removes the bookmark and reports whether there was one
+	Delete(ctx context.Context, userID, postID uint) (bool, error)
 	Exists(ctx context.Context, userID, postID uint) (bool, error)
-	CountByPostID(ctx context.Context, postID uint) (int64, error)
+	FindPostIDsByUser(ctx context.Context, userID uint, postIDs []uint) (map[uint]bool, error)
 }
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+type FollowRepository interface {
+	// Create records follow and reports whether it did not exist yet
+	Create(ctx context.Context, follow *models.Follow) (bool, error)
+	// Delete removes the follow and reports whether there was one
+	Delete(ctx context.Context, followerID, followeeID uint) (bool, error)
+	Exists(ctx context.Context, followerID, followeeID uint) (bool, error)
+	ListFollowers(ctx context.Context, userID uint, limit, offset int) ([]*models.User, error)
+	ListFollowing(ctx context.Context, userID uint, limit, offset int) ([]*models.User, error)
+	ListFolloweeIDs(ctx context.Context, userID uint) ([]uint, error)
+	ListFollowerIDs(ctx context.Context, userID uint, afterID uint, limit int) ([]uint, error)
+	ListFolloweeIDsWithMinFollowers(ctx context.Context, userID uint, minFollowers int64) ([]uint, error)
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+type FollowRequestRepository interface {
+	Create(ctx context.Context, request *models.FollowRequest) error
+	// Delete removes a pending request and reports whether there was one
+	Delete(ctx context.Context, requesterID, targetID uint) (bool, error)
+	Exists(ctx context.Context, requesterID, targetID uint) (bool, error)
+	// ListRequesters returns the users waiting on targetID, oldest request first
+	ListRequesters(ctx context.Context, targetID uint, limit, offset int) ([]*models.User, error)
+	ListRequesterIDs(ctx context.Context, targetID uint) ([]uint, error)
+}
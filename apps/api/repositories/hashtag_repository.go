@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+type HashtagRepository interface {
+	// AttachToPost records that postID uses each of tags, creating the tags
+	// as needed. tags must already be normalized.
+	AttachToPost(ctx context.Context, postID uint, postCreatedAt time.Time, tags []string) error
+	DetachFromPost(ctx context.Context, postID uint) error
+}
@@ -7,8 +7,14 @@ import (
 )
 
 type LikeRepository interface {
-	Create(ctx context.Context, like *models.Like) error
-	Delete(ctx context.Context, userID, postID uint) error
+	// Create records like and reports whether it did not exist yet
+	Create(ctx context.Context, like *models.Like) (bool, error)
+	// Delete removes the like and reports whether there was one
+	Delete(ctx context.Context, userID, postID uint) (bool, error)
 	Exists(ctx context.Context, userID, postID uint) (bool, error)
-	CountByPostID(ctx context.Context, postID uint) (int64, error)
+	FindPostIDsByUser(ctx context.Context, userID uint, postIDs []uint) (map[uint]bool, error)
+	// ListLikers returns users who liked postID, accounts viewerID follows
+	// first, then most recent likes first. Users on either side of a block
+	// with viewerID are left out
+	ListLikers(ctx context.Context, postID, viewerID uint, limit, offset int) ([]*models.User, error)
 }
@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+type MediaRepository interface {
+	Create(ctx context.Context, media *models.Media) error
+	FindByID(ctx context.Context, mediaID uint) (*models.Media, error)
+	// FindByIDForUpdate loads the media row and locks it until the
+	// surrounding transaction ends
+	FindByIDForUpdate(ctx context.Context, mediaID uint) (*models.Media, error)
+	// ListStalePending returns up to limit IDs of media uploaded before
+	// cutoff that are still waiting to be processed, oldest first
+	ListStalePending(ctx context.Context, cutoff time.Time, limit int) ([]uint, error)
+	// AttachToPost assigns mediaIDs, in order, to postID. It fails with
+	// ErrInvalidInput unless every ID is an unattached upload by ownerID.
+	AttachToPost(ctx context.Context, postID, ownerID uint, mediaIDs []uint) error
+	ListByPostID(ctx context.Context, postID uint) ([]*models.Media, error)
+	ListByPostIDs(ctx context.Context, postIDs []uint) ([]*models.Media, error)
+	DeleteByPostIDs(ctx context.Context, postIDs []uint) error
+	CreateVariants(ctx context.Context, variants []models.MediaVariant) error
+	// UpdateProcessing records the processing outcome for mediaID
+	UpdateProcessing(ctx context.Context, mediaID uint, status, blurHash string) error
+}
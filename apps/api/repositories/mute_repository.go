@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+type MuteRepository interface {
+	Create(ctx context.Context, mute *models.Mute) error
+	Delete(ctx context.Context, muterID, mutedID uint) error
+	ListMuted(ctx context.Context, muterID uint, limit, offset int) ([]*models.User, error)
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+type MutedWordRepository interface {
+	Create(ctx context.Context, word *models.MutedWord) error
+	// FindByID returns userID's muted word id, or ErrMutedWordNotFound
+	FindByID(ctx context.Context, userID, id uint) (*models.MutedWord, error)
+	Update(ctx context.Context, word *models.MutedWord) error
+	Delete(ctx context.Context, userID, id uint) error
+	ListByUser(ctx context.Context, userID uint) ([]*models.MutedWord, error)
+	// ListActive returns userID's muted words that have not expired at now
+	ListActive(ctx context.Context, userID uint, now time.Time) ([]*models.MutedWord, error)
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+type NotificationRepository interface {
+	Create(ctx context.Context, notification *models.Notification) error
+	ListGroups(ctx context.Context, recipientID uint, limit, offset int) ([]*models.NotificationGroup, error)
+	// ListUnreadPosts returns the posts behind the recipient's unread
+	// notifications of the MutableNotificationTypes
+	ListUnreadPosts(ctx context.Context, recipientID uint) ([]*models.Post, error)
+	// CountUnread counts the recipient's unread notifications, leaving out
+	// those of the MutableNotificationTypes about hiddenPostIDs
+	CountUnread(ctx context.Context, recipientID uint, hiddenPostIDs []uint) (int64, error)
+	MarkAllRead(ctx context.Context, recipientID uint) error
+}
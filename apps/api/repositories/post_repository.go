@@ -2,18 +2,84 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
 )
 
 type PostRepository interface {
 	Create(ctx context.Context, post *models.Post) error
-	List(ctx context.Context, limit, offset int, targetUserID *uint) ([]*models.Post, error)
-	GetBookmarkedPosts(ctx context.Context, userID uint, limit, offset int) ([]*models.Post, error)
-	CountReplies(ctx context.Context, postID uint) (int64, error)
-	CountReposts(ctx context.Context, postID uint) (int64, error)
+	List(ctx context.Context, targetUserID *uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error)
+	ListHomeTimeline(ctx context.Context, userID uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error)
+	ListHomeTimelineEntries(ctx context.Context, userID uint, limit int) ([]models.TimelineEntry, error)
+	ListByAuthors(ctx context.Context, authorIDs []uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error)
+	GetBookmarkedPosts(ctx context.Context, userID uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error)
+	// GetLikedPosts pages through the posts userID liked, most recent like
+	// first. The cursor encodes (likes.created_at, post_id).
+	GetLikedPosts(ctx context.Context, userID uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error)
+	ListByHashtag(ctx context.Context, tag string, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error)
+	Search(ctx context.Context, query *models.PostSearchQuery, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error)
+	ListMentioning(ctx context.Context, userID uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error)
+	// ListQuotes pages through posts that embed postID with content of their
+	// own, newest first. Plain reposts are excluded.
+	ListQuotes(ctx context.Context, postID uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error)
+	CountByAuthorID(ctx context.Context, authorID uint) (int64, error)
 	CheckReposted(ctx context.Context, userID uint, postID uint) (bool, error)
-	GetReplies(ctx context.Context, postID uint) ([]*models.Post, error)
-	Delete(ctx context.Context, postID uint) error
+	FindRepostedPostIDs(ctx context.Context, userID uint, postIDs []uint) (map[uint]bool, error)
+	// FindRepliablePostIDs reports which of postIDs userID may reply to under
+	// their reply policies
+	FindRepliablePostIDs(ctx context.Context, userID uint, postIDs []uint) (map[uint]bool, error)
+	// FindRepost returns userID's plain repost of postID
+	FindRepost(ctx context.Context, userID uint, postID uint) (*models.Post, error)
+	GetReplies(ctx context.Context, postID uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error)
+	// Delete soft-deletes postID and reports whether it was still live
+	Delete(ctx context.Context, postID uint) (bool, error)
 	FindByID(ctx context.Context, postID uint) (*models.Post, error)
+	FindByIDs(ctx context.Context, postIDs []uint) ([]*models.Post, error)
+	// FindByIDIncludingDeleted is FindByID that also returns soft-deleted posts
+	FindByIDIncludingDeleted(ctx context.Context, postID uint) (*models.Post, error)
+	// GetThreadPath returns postID and up to maxAncestors of the posts it
+	// replies to, root first and postID last. Deleted posts are included.
+	GetThreadPath(ctx context.Context, postID uint, maxAncestors int) ([]*models.Post, error)
+	// ListReplyTree returns up to limit replies below postID, at most maxDepth
+	// levels deep, shallowest first and ranked by order within each level.
+	// Deleted replies are included only while they have replies of their own.
+	ListReplyTree(ctx context.Context, postID, conversationID uint, maxDepth int, order ReplyOrder, limit int) ([]*models.Post, error)
+	// FindByIDForUpdate loads the bare post row and locks it until the
+	// surrounding transaction ends
+	FindByIDForUpdate(ctx context.Context, postID uint) (*models.Post, error)
+	UpdateContent(ctx context.Context, postID uint, content string, editedAt time.Time) error
+	ReplaceMentions(ctx context.Context, postID uint, mentions []models.PostMention) error
+	IncrementCounter(ctx context.Context, postID uint, counter PostCounter, delta int64) error
+	ReconcileCounters(ctx context.Context) (int64, error)
+	// ListPurgeable returns up to limit posts deleted before cutoff whose
+	// content has not been purged yet
+	ListPurgeable(ctx context.Context, cutoff time.Time, limit int) ([]uint, error)
+	// PurgeContent blanks the content of deleted posts and drops their
+	// mentions, hashtags, revisions, likes, bookmarks and notifications
+	PurgeContent(ctx context.Context, postIDs []uint, purgedAt time.Time) error
+	// DeleteUnreferenced hard-deletes those of postIDs that no reply or
+	// repost points at, returning how many were removed
+	DeleteUnreferenced(ctx context.Context, postIDs []uint) (int64, error)
 }
+
+// ReplyOrder selects how sibling replies in a conversation are ranked
+type ReplyOrder string
+
+const (
+	// ReplyOrderEngagement ranks replies by likes, replies and reposts
+	ReplyOrderEngagement ReplyOrder = "engagement"
+	ReplyOrderNewest     ReplyOrder = "newest"
+	ReplyOrderOldest     ReplyOrder = "oldest"
+)
+
+// PostCounter names a denormalized engagement counter column on posts.
+type PostCounter string
+
+const (
+	PostCounterLikes     PostCounter = "like_count"
+	PostCounterBookmarks PostCounter = "bookmark_count"
+	PostCounterReplies   PostCounter = "reply_count"
+	PostCounterReposts   PostCounter = "repost_count"
+	PostCounterQuotes    PostCounter = "quote_count"
+)
@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+)
+
+type PostRevisionRepository interface {
+	Create(ctx context.Context, revision *models.PostRevision) error
+	ListByPostID(ctx context.Context, postID uint) ([]*models.PostRevision, error)
+}
@@ -0,0 +1,9 @@
+package repositories
+
+import "context"
+
+// TransactionManager runs fn inside a single database transaction.
+// Repository calls made with the context passed to fn join that transaction.
+type TransactionManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
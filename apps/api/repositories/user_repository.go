@@ -6,9 +6,32 @@ import (
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
 )
 
+// UserSearchOrder selects how user search results are ranked
+type UserSearchOrder string
+
+const (
+	// UserSearchOrderRelevance puts username prefix matches first, then
+	// ranks by trigram similarity
+	UserSearchOrderRelevance UserSearchOrder = "relevance"
+	// UserSearchOrderFollowers ranks matches by follower count
+	UserSearchOrderFollowers UserSearchOrder = "followers"
+)
+
 type UserRepository interface {
 	Create(ctx context.Context, user *models.User) error
 	FindByEmail(ctx context.Context, email string) (*models.User, error)
 	FindByUsername(ctx context.Context, username string) (*models.User, error)
+	FindByUsernames(ctx context.Context, usernames []string) ([]*models.User, error)
 	FindByID(ctx context.Context, id uint) (*models.User, error)
+	UpdatePrivacy(ctx context.Context, userID uint, isPrivate bool) error
+	// UpdateProfile saves the display name, bio, location, website and
+	// birthday of user. Profile images are left alone.
+	UpdateProfile(ctx context.Context, user *models.User) error
+	// SetProfileImage points the user's avatar or banner at key and returns
+	// the key it replaced
+	SetProfileImage(ctx context.Context, userID uint, kind models.ProfileImage, key, url string) (string, error)
+	IncrementFollowCounts(ctx context.Context, followerID, followeeID uint, delta int64) error
+	ReconcileFollowCounts(ctx context.Context) (int64, error)
+	Search(ctx context.Context, q string, order UserSearchOrder, limit, offset int) ([]*models.User, error)
+	AutocompleteUsernames(ctx context.Context, viewerID uint, prefix string, limit int) ([]*models.User, error)
 }
@@ -0,0 +1,18 @@
+package repositories
+
+import "context"
+
+type viewerKey struct{}
+
+// WithViewer returns a copy of ctx that reads on behalf of userID. Post reads
+// made with it hide posts from users the viewer has blocked or been blocked
+// by, and feed reads also hide users the viewer has muted.
+func WithViewer(ctx context.Context, userID uint) context.Context {
+	return context.WithValue(ctx, viewerKey{}, userID)
+}
+
+// ViewerFromContext returns the user ctx reads on behalf of, if any.
+func ViewerFromContext(ctx context.Context) (uint, bool) {
+	userID, ok := ctx.Value(viewerKey{}).(uint)
+	return userID, ok
+}
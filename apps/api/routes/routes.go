@@ -6,7 +6,7 @@ import (
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/presentation/middlewares"
 )
 
-func SetupRoutes(router *gin.Engine, userHandler *handlers.UserHandler, authHandler *handlers.AuthHandler, postHandler *handlers.PostHandler, likeHandler *handlers.LikeHandler, bookmarkHandler *handlers.BookmarkHandler, authMiddleware *middlewares.AuthMiddleware) {
+func SetupRoutes(router *gin.Engine, userHandler *handlers.UserHandler, authHandler *handlers.AuthHandler, postHandler *handlers.PostHandler, likeHandler *handlers.LikeHandler, bookmarkHandler *handlers.BookmarkHandler, followHandler *handlers.FollowHandler, blockHandler *handlers.BlockHandler, muteHandler *handlers.MuteHandler, streamHandler *handlers.StreamHandler, notificationHandler *handlers.NotificationHandler, hashtagHandler *handlers.HashtagHandler, searchHandler *handlers.SearchHandler, mediaHandler *handlers.MediaHandler, authMiddleware *middlewares.AuthMiddleware) {
 	api := router.Group("/api")
 	{
 		api.POST("/users", userHandler.CreateUser)
@@ -16,15 +16,54 @@ func SetupRoutes(router *gin.Engine, userHandler *handlers.UserHandler, authHand
 		authorized.Use(authMiddleware.Handle())
 		{
 			authorized.GET("/users/:username", userHandler.GetProfile)
+			authorized.POST("/users/:username/follow", followHandler.Follow)
+			authorized.DELETE("/users/:username/follow", followHandler.Unfollow)
+			authorized.GET("/users/:username/followers", followHandler.GetFollowers)
+			authorized.GET("/users/:username/following", followHandler.GetFollowing)
+			authorized.GET("/users/:username/likes", likeHandler.GetLikedPosts)
+			authorized.POST("/users/:username/block", blockHandler.Block)
+			authorized.DELETE("/users/:username/block", blockHandler.Unblock)
+			authorized.POST("/users/:username/mute", muteHandler.Mute)
+			authorized.DELETE("/users/:username/mute", muteHandler.Unmute)
+			authorized.GET("/blocks", blockHandler.GetBlocked)
+			authorized.GET("/mutes", muteHandler.GetMuted)
+			authorized.GET("/muted_words", muteHandler.GetMutedWords)
+			authorized.POST("/muted_words", muteHandler.CreateMutedWord)
+			authorized.PATCH("/muted_words/:id", muteHandler.UpdateMutedWord)
+			authorized.DELETE("/muted_words/:id", muteHandler.DeleteMutedWord)
 			authorized.GET("/me", userHandler.GetMe)
+			authorized.PATCH("/me", userHandler.UpdateMe)
+			authorized.PUT("/me/avatar", userHandler.UploadAvatar)
+			authorized.PUT("/me/banner", userHandler.UploadBanner)
+			authorized.PUT("/me/privacy", followHandler.UpdatePrivacy)
+			authorized.GET("/follow_requests", followHandler.GetFollowRequests)
+			authorized.POST("/follow_requests/:username/approve", followHandler.ApproveFollowRequest)
+			authorized.POST("/follow_requests/:username/deny", followHandler.DenyFollowRequest)
+			authorized.POST("/media", mediaHandler.Upload)
 			authorized.POST("/posts", postHandler.CreatePost)
 			authorized.GET("/posts", postHandler.GetTimeline)
 			authorized.POST("/posts/:id/like", likeHandler.ToggleLike)
+			authorized.GET("/posts/:id/likes", likeHandler.GetLikers)
 			authorized.POST("/posts/:id/bookmark", bookmarkHandler.ToggleBookmark)
 			authorized.GET("/bookmarks", postHandler.GetBookmarks)
+			authorized.GET("/mentions", postHandler.GetMentions)
+			authorized.GET("/hashtags/trending", hashtagHandler.GetTrending)
+			authorized.GET("/hashtags/:tag/posts", hashtagHandler.GetPosts)
+			authorized.GET("/search/posts", searchHandler.SearchPosts)
+			authorized.GET("/search/users", searchHandler.SearchUsers)
+			authorized.GET("/search/users/autocomplete", searchHandler.AutocompleteUsers)
 			authorized.GET("/posts/:id", postHandler.GetPostDetail)
 			authorized.GET("/posts/:id/replies", postHandler.GetReplies)
+			authorized.GET("/posts/:id/conversation", postHandler.GetConversation)
+			authorized.GET("/posts/:id/quotes", postHandler.GetQuotes)
+			authorized.DELETE("/posts/:id/repost", postHandler.UndoRepost)
+			authorized.PATCH("/posts/:id", postHandler.EditPost)
+			authorized.GET("/posts/:id/revisions", postHandler.GetRevisions)
 			authorized.DELETE("/posts/:id", postHandler.DeletePost)
+			authorized.GET("/stream", streamHandler.Stream)
+			authorized.GET("/notifications", notificationHandler.GetNotifications)
+			authorized.GET("/notifications/unread_count", notificationHandler.GetUnreadCount)
+			authorized.POST("/notifications/read", notificationHandler.MarkAllRead)
 		}
 	}
 }
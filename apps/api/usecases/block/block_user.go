@@ -0,0 +1,82 @@
+package block
+
+import (
+	"context"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	infraTimeline "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/timeline"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type BlockUserUseCase struct {
+	blockRepo     repositories.BlockRepository
+	followRepo    repositories.FollowRepository
+	requestRepo   repositories.FollowRequestRepository
+	userRepo      repositories.UserRepository
+	txManager     repositories.TransactionManager
+	timelineCache *infraTimeline.TimelineCache
+}
+
+func NewBlockUserUseCase(blockRepo repositories.BlockRepository, followRepo repositories.FollowRepository, requestRepo repositories.FollowRequestRepository, userRepo repositories.UserRepository, txManager repositories.TransactionManager, timelineCache *infraTimeline.TimelineCache) *BlockUserUseCase {
+	return &BlockUserUseCase{
+		blockRepo:     blockRepo,
+		followRepo:    followRepo,
+		requestRepo:   requestRepo,
+		userRepo:      userRepo,
+		txManager:     txManager,
+		timelineCache: timelineCache,
+	}
+}
+
+type BlockOutput struct {
+	IsBlocking bool `json:"is_blocking"`
+}
+
+// Execute blocks username on behalf of blockerID and removes any follow or
+// follow request between the two in either direction.
+func (uc *BlockUserUseCase) Execute(ctx context.Context, blockerID uint, username string) (*BlockOutput, error) {
+	target, err := uc.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users cannot block themselves
+	if target.ID == blockerID {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := uc.blockRepo.Create(ctx, &models.Block{BlockerID: blockerID, BlockedID: target.ID}); err != nil {
+			return err
+		}
+		if err := uc.unfollow(ctx, blockerID, target.ID); err != nil {
+			return err
+		}
+		return uc.unfollow(ctx, target.ID, blockerID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Both home timelines may still hold the other user's posts
+	for _, userID := range []uint{blockerID, target.ID} {
+		if err := uc.timelineCache.Invalidate(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &BlockOutput{IsBlocking: true}, nil
+}
+
+func (uc *BlockUserUseCase) unfollow(ctx context.Context, followerID, followeeID uint) error {
+	if _, err := uc.requestRepo.Delete(ctx, followerID, followeeID); err != nil {
+		return err
+	}
+
+	removed, err := uc.followRepo.Delete(ctx, followerID, followeeID)
+	if err != nil || !removed {
+		return err
+	}
+	return uc.userRepo.IncrementFollowCounts(ctx, followerID, followeeID, -1)
+}
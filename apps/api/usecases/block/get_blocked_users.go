@@ -0,0 +1,20 @@
+package block
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type GetBlockedUsersUseCase struct {
+	blockRepo repositories.BlockRepository
+}
+
+func NewGetBlockedUsersUseCase(blockRepo repositories.BlockRepository) *GetBlockedUsersUseCase {
+	return &GetBlockedUsersUseCase{blockRepo: blockRepo}
+}
+
+func (uc *GetBlockedUsersUseCase) Execute(ctx context.Context, userID uint, limit, offset int) ([]*models.User, error) {
+	return uc.blockRepo.ListBlocked(ctx, userID, limit, offset)
+}
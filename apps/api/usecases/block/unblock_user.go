@@ -0,0 +1,33 @@
+package block
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type UnblockUserUseCase struct {
+	blockRepo repositories.BlockRepository
+	userRepo  repositories.UserRepository
+}
+
+func NewUnblockUserUseCase(blockRepo repositories.BlockRepository, userRepo repositories.UserRepository) *UnblockUserUseCase {
+	return &UnblockUserUseCase{
+		blockRepo: blockRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Execute lifts blockerID's block on username. Follows removed by the block
+// are not restored.
+func (uc *UnblockUserUseCase) Execute(ctx context.Context, blockerID uint, username string) (*BlockOutput, error) {
+	target, err := uc.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.blockRepo.Delete(ctx, blockerID, target.ID); err != nil {
+		return nil, err
+	}
+	return &BlockOutput{IsBlocking: false}, nil
+}
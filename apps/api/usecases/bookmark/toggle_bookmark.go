@@ -9,45 +9,76 @@ import (
 
 type ToggleBookmarkUseCase struct {
 	bookmarkRepo repositories.BookmarkRepository
+	postRepo     repositories.PostRepository
+	txManager    repositories.TransactionManager
 }
 
-func NewToggleBookmarkUseCase(bookmarkRepo repositories.BookmarkRepository) *ToggleBookmarkUseCase {
-	return &ToggleBookmarkUseCase{bookmarkRepo: bookmarkRepo}
+func NewToggleBookmarkUseCase(bookmarkRepo repositories.BookmarkRepository, postRepo repositories.PostRepository, txManager repositories.TransactionManager) *ToggleBookmarkUseCase {
+	return &ToggleBookmarkUseCase{
+		bookmarkRepo: bookmarkRepo,
+		postRepo:     postRepo,
+		txManager:    txManager,
+	}
 }
 
 type ToggleBookmarkOutput struct {
-	IsBookmarked   bool  `json:"is_bookmarked"`
+	IsBookmarked  bool  `json:"is_bookmarked"`
 	BookmarkCount int64 `json:"bookmark_count"`
 }
 
 func (uc *ToggleBookmarkUseCase) Execute(ctx context.Context, userID, postID uint) (*ToggleBookmarkOutput, error) {
-	exists, err := uc.bookmarkRepo.Exists(ctx, userID, postID)
-	if err != nil {
-		return nil, err
-	}
+	var isBookmarked bool
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		exists, err := uc.bookmarkRepo.Exists(ctx, userID, postID)
+		if err != nil {
+			return err
+		}
 
-	if exists {
-		if err := uc.bookmarkRepo.Delete(ctx, userID, postID); err != nil {
-			return nil, err
+		if exists {
+			removed, err := uc.bookmarkRepo.Delete(ctx, userID, postID)
+			if err != nil {
+				return err
+			}
+			isBookmarked = false
+			// A concurrent unbookmark may have removed it first
+			if !removed {
+				return nil
+			}
+			return uc.postRepo.IncrementCounter(ctx, postID, repositories.PostCounterBookmarks, -1)
 		}
-	} else {
+
+		// Posts hidden from the caller cannot be bookmarked, only unbookmarked
+		if _, err := uc.postRepo.FindByID(repositories.WithViewer(ctx, userID), postID); err != nil {
+			return err
+		}
+
 		bookmark := &models.Bookmark{
 			UserID: userID,
 			PostID: postID,
 		}
-		if err := uc.bookmarkRepo.Create(ctx, bookmark); err != nil {
-			return nil, err
+		created, err := uc.bookmarkRepo.Create(ctx, bookmark)
+		if err != nil {
+			return err
+		}
+		isBookmarked = true
+		// A concurrent bookmark may have inserted it first
+		if !created {
+			return nil
 		}
+		return uc.postRepo.IncrementCounter(ctx, postID, repositories.PostCounterBookmarks, 1)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Fetch updated count
-	count, err := uc.bookmarkRepo.CountByPostID(ctx, postID)
+	post, err := uc.postRepo.FindByID(ctx, postID)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ToggleBookmarkOutput{
-		IsBookmarked:   !exists,
-		BookmarkCount: count,
+		IsBookmarked:  isBookmarked,
+		BookmarkCount: post.BookmarkCount,
 	}, nil
 }
@@ -0,0 +1,63 @@
+package follow
+
+import (
+	"context"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	infraTimeline "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/timeline"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type ApproveFollowRequestUseCase struct {
+	followRepo    repositories.FollowRepository
+	requestRepo   repositories.FollowRequestRepository
+	userRepo      repositories.UserRepository
+	txManager     repositories.TransactionManager
+	timelineCache *infraTimeline.TimelineCache
+}
+
+func NewApproveFollowRequestUseCase(followRepo repositories.FollowRepository, requestRepo repositories.FollowRequestRepository, userRepo repositories.UserRepository, txManager repositories.TransactionManager, timelineCache *infraTimeline.TimelineCache) *ApproveFollowRequestUseCase {
+	return &ApproveFollowRequestUseCase{
+		followRepo:    followRepo,
+		requestRepo:   requestRepo,
+		userRepo:      userRepo,
+		txManager:     txManager,
+		timelineCache: timelineCache,
+	}
+}
+
+// Execute lets username follow ownerID, consuming their pending request.
+func (uc *ApproveFollowRequestUseCase) Execute(ctx context.Context, ownerID uint, username string) error {
+	requester, err := uc.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		pending, err := uc.requestRepo.Delete(ctx, requester.ID, ownerID)
+		if err != nil {
+			return err
+		}
+		if !pending {
+			return domainErrors.ErrFollowRequestNotFound
+		}
+		return approve(ctx, uc.followRepo, uc.userRepo, requester.ID, ownerID)
+	})
+	if err != nil {
+		return err
+	}
+
+	// The requester's home timeline now includes the owner's posts
+	return uc.timelineCache.Invalidate(ctx, requester.ID)
+}
+
+// approve turns a consumed follow request into a follow.
+func approve(ctx context.Context, followRepo repositories.FollowRepository, userRepo repositories.UserRepository, requesterID, ownerID uint) error {
+	// The requester may already follow the owner through another path
+	inserted, err := followRepo.Create(ctx, &models.Follow{FollowerID: requesterID, FolloweeID: ownerID})
+	if err != nil || !inserted {
+		return err
+	}
+	return userRepo.IncrementFollowCounts(ctx, requesterID, ownerID, 1)
+}
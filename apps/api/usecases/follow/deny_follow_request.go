@@ -0,0 +1,38 @@
+package follow
+
+import (
+	"context"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type DenyFollowRequestUseCase struct {
+	requestRepo repositories.FollowRequestRepository
+	userRepo    repositories.UserRepository
+}
+
+func NewDenyFollowRequestUseCase(requestRepo repositories.FollowRequestRepository, userRepo repositories.UserRepository) *DenyFollowRequestUseCase {
+	return &DenyFollowRequestUseCase{
+		requestRepo: requestRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// Execute drops username's pending request to follow ownerID. The requester
+// is not told and may ask again.
+func (uc *DenyFollowRequestUseCase) Execute(ctx context.Context, ownerID uint, username string) error {
+	requester, err := uc.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	pending, err := uc.requestRepo.Delete(ctx, requester.ID, ownerID)
+	if err != nil {
+		return err
+	}
+	if !pending {
+		return domainErrors.ErrFollowRequestNotFound
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package follow
+
+import (
+	"context"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	infraTimeline "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/timeline"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type FollowUserUseCase struct {
+	followRepo       repositories.FollowRepository
+	blockRepo        repositories.BlockRepository
+	requestRepo      repositories.FollowRequestRepository
+	userRepo         repositories.UserRepository
+	notificationRepo repositories.NotificationRepository
+	txManager        repositories.TransactionManager
+	timelineCache    *infraTimeline.TimelineCache
+}
+
+func NewFollowUserUseCase(followRepo repositories.FollowRepository, blockRepo repositories.BlockRepository, requestRepo repositories.FollowRequestRepository, userRepo repositories.UserRepository, notificationRepo repositories.NotificationRepository, txManager repositories.TransactionManager, timelineCache *infraTimeline.TimelineCache) *FollowUserUseCase {
+	return &FollowUserUseCase{
+		followRepo:       followRepo,
+		blockRepo:        blockRepo,
+		requestRepo:      requestRepo,
+		userRepo:         userRepo,
+		notificationRepo: notificationRepo,
+		txManager:        txManager,
+		timelineCache:    timelineCache,
+	}
+}
+
+// FollowOutput is the relationship after a follow change. IsRequested is
+// set while a request to follow a private account awaits approval.
+type FollowOutput struct {
+	IsFollowing   bool  `json:"is_following"`
+	IsRequested   bool  `json:"is_requested"`
+	FollowerCount int64 `json:"follower_count"`
+}
+
+func (uc *FollowUserUseCase) Execute(ctx context.Context, followerID uint, username string) (*FollowOutput, error) {
+	target, err := uc.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users cannot follow themselves
+	if target.ID == followerID {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	// Neither side of a block can follow the other
+	blocked, err := uc.blockRepo.ExistsBetween(ctx, followerID, target.ID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, domainErrors.ErrBlocked
+	}
+
+	created := false
+	isFollowing := true
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		// Following is idempotent
+		exists, err := uc.followRepo.Exists(ctx, followerID, target.ID)
+		if err != nil || exists {
+			return err
+		}
+
+		// Private accounts approve their followers
+		if target.IsPrivate {
+			isFollowing = false
+			return uc.request(ctx, followerID, target.ID)
+		}
+
+		follow := &models.Follow{
+			FollowerID: followerID,
+			FolloweeID: target.ID,
+		}
+		// A concurrent follow may have inserted it first
+		inserted, err := uc.followRepo.Create(ctx, follow)
+		if err != nil || !inserted {
+			return err
+		}
+		created = true
+		if err := uc.userRepo.IncrementFollowCounts(ctx, followerID, target.ID, 1); err != nil {
+			return err
+		}
+		return uc.notificationRepo.Create(ctx, &models.Notification{
+			RecipientID: target.ID,
+			ActorID:     followerID,
+			Type:        models.NotificationTypeFollow,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The cached home timeline no longer matches the set of followed accounts
+	if created {
+		if err := uc.timelineCache.Invalidate(ctx, followerID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Fetch updated count
+	target, err = uc.userRepo.FindByID(ctx, target.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FollowOutput{
+		IsFollowing:   isFollowing,
+		IsRequested:   !isFollowing,
+		FollowerCount: target.FollowerCount,
+	}, nil
+}
+
+// request asks targetID to approve followerID, notifying them the first
+// time.
+func (uc *FollowUserUseCase) request(ctx context.Context, followerID, targetID uint) error {
+	pending, err := uc.requestRepo.Exists(ctx, followerID, targetID)
+	if err != nil || pending {
+		return err
+	}
+
+	if err := uc.requestRepo.Create(ctx, &models.FollowRequest{RequesterID: followerID, TargetID: targetID}); err != nil {
+		return err
+	}
+	return uc.notificationRepo.Create(ctx, &models.Notification{
+		RecipientID: targetID,
+		ActorID:     followerID,
+		Type:        models.NotificationTypeFollowRequest,
+	})
+}
@@ -0,0 +1,21 @@
+package follow
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type GetFollowRequestsUseCase struct {
+	requestRepo repositories.FollowRequestRepository
+}
+
+func NewGetFollowRequestsUseCase(requestRepo repositories.FollowRequestRepository) *GetFollowRequestsUseCase {
+	return &GetFollowRequestsUseCase{requestRepo: requestRepo}
+}
+
+// Execute lists the users waiting for ownerID to approve them, oldest first.
+func (uc *GetFollowRequestsUseCase) Execute(ctx context.Context, ownerID uint, limit, offset int) ([]*models.User, error) {
+	return uc.requestRepo.ListRequesters(ctx, ownerID, limit, offset)
+}
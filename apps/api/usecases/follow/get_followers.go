@@ -0,0 +1,29 @@
+package follow
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type GetFollowersUseCase struct {
+	followRepo repositories.FollowRepository
+	userRepo   repositories.UserRepository
+}
+
+func NewGetFollowersUseCase(followRepo repositories.FollowRepository, userRepo repositories.UserRepository) *GetFollowersUseCase {
+	return &GetFollowersUseCase{
+		followRepo: followRepo,
+		userRepo:   userRepo,
+	}
+}
+
+func (uc *GetFollowersUseCase) Execute(ctx context.Context, username string, limit, offset int) ([]*models.User, error) {
+	user, err := uc.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.followRepo.ListFollowers(ctx, user.ID, limit, offset)
+}
@@ -0,0 +1,29 @@
+package follow
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type GetFollowingUseCase struct {
+	followRepo repositories.FollowRepository
+	userRepo   repositories.UserRepository
+}
+
+func NewGetFollowingUseCase(followRepo repositories.FollowRepository, userRepo repositories.UserRepository) *GetFollowingUseCase {
+	return &GetFollowingUseCase{
+		followRepo: followRepo,
+		userRepo:   userRepo,
+	}
+}
+
+func (uc *GetFollowingUseCase) Execute(ctx context.Context, username string, limit, offset int) ([]*models.User, error) {
+	user, err := uc.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.followRepo.ListFollowing(ctx, user.ID, limit, offset)
+}
@@ -0,0 +1,75 @@
+package follow
+
+import (
+	"context"
+
+	infraTimeline "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/timeline"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type UnfollowUserUseCase struct {
+	followRepo    repositories.FollowRepository
+	requestRepo   repositories.FollowRequestRepository
+	userRepo      repositories.UserRepository
+	txManager     repositories.TransactionManager
+	timelineCache *infraTimeline.TimelineCache
+}
+
+func NewUnfollowUserUseCase(followRepo repositories.FollowRepository, requestRepo repositories.FollowRequestRepository, userRepo repositories.UserRepository, txManager repositories.TransactionManager, timelineCache *infraTimeline.TimelineCache) *UnfollowUserUseCase {
+	return &UnfollowUserUseCase{
+		followRepo:    followRepo,
+		requestRepo:   requestRepo,
+		userRepo:      userRepo,
+		txManager:     txManager,
+		timelineCache: timelineCache,
+	}
+}
+
+func (uc *UnfollowUserUseCase) Execute(ctx context.Context, followerID uint, username string) (*FollowOutput, error) {
+	target, err := uc.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := false
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		exists, err := uc.followRepo.Exists(ctx, followerID, target.ID)
+		if err != nil {
+			return err
+		}
+		// Unfollowing before approval withdraws the request
+		if !exists {
+			_, err := uc.requestRepo.Delete(ctx, followerID, target.ID)
+			return err
+		}
+
+		// A concurrent unfollow may have removed it first
+		removed, err := uc.followRepo.Delete(ctx, followerID, target.ID)
+		if err != nil || !removed {
+			return err
+		}
+		deleted = true
+		return uc.userRepo.IncrementFollowCounts(ctx, followerID, target.ID, -1)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The cached home timeline no longer matches the set of followed accounts
+	if deleted {
+		if err := uc.timelineCache.Invalidate(ctx, followerID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Fetch updated count
+	target, err = uc.userRepo.FindByID(ctx, target.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FollowOutput{
+		IsFollowing:   false,
+		FollowerCount: target.FollowerCount,
+	}, nil
+}
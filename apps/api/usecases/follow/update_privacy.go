@@ -0,0 +1,65 @@
+package follow
+
+import (
+	"context"
+
+	infraTimeline "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/timeline"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type UpdatePrivacyUseCase struct {
+	followRepo    repositories.FollowRepository
+	requestRepo   repositories.FollowRequestRepository
+	userRepo      repositories.UserRepository
+	txManager     repositories.TransactionManager
+	timelineCache *infraTimeline.TimelineCache
+}
+
+func NewUpdatePrivacyUseCase(followRepo repositories.FollowRepository, requestRepo repositories.FollowRequestRepository, userRepo repositories.UserRepository, txManager repositories.TransactionManager, timelineCache *infraTimeline.TimelineCache) *UpdatePrivacyUseCase {
+	return &UpdatePrivacyUseCase{
+		followRepo:    followRepo,
+		requestRepo:   requestRepo,
+		userRepo:      userRepo,
+		txManager:     txManager,
+		timelineCache: timelineCache,
+	}
+}
+
+// Execute makes userID's account private or public. Existing followers keep
+// following either way; going public approves every pending request.
+func (uc *UpdatePrivacyUseCase) Execute(ctx context.Context, userID uint, isPrivate bool) error {
+	var approved []uint
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.UpdatePrivacy(ctx, userID, isPrivate); err != nil {
+			return err
+		}
+		if isPrivate {
+			return nil
+		}
+
+		requesterIDs, err := uc.requestRepo.ListRequesterIDs(ctx, userID)
+		if err != nil {
+			return err
+		}
+		for _, requesterID := range requesterIDs {
+			if _, err := uc.requestRepo.Delete(ctx, requesterID, userID); err != nil {
+				return err
+			}
+			if err := approve(ctx, uc.followRepo, uc.userRepo, requesterID, userID); err != nil {
+				return err
+			}
+		}
+		approved = requesterIDs
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, requesterID := range approved {
+		if err := uc.timelineCache.Invalidate(ctx, requesterID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package hashtag
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	infraTrending "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/trending"
+)
+
+type GetTrendingHashtagsUseCase struct {
+	hashtagTracker *infraTrending.HashtagTracker
+}
+
+func NewGetTrendingHashtagsUseCase(hashtagTracker *infraTrending.HashtagTracker) *GetTrendingHashtagsUseCase {
+	return &GetTrendingHashtagsUseCase{
+		hashtagTracker: hashtagTracker,
+	}
+}
+
+func (uc *GetTrendingHashtagsUseCase) Execute(ctx context.Context, limit int) ([]models.TrendingHashtag, error) {
+	return uc.hashtagTracker.Top(ctx, limit)
+}
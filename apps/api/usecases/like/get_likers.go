@@ -0,0 +1,31 @@
+package like
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type GetLikersUseCase struct {
+	likeRepo repositories.LikeRepository
+	postRepo repositories.PostRepository
+}
+
+func NewGetLikersUseCase(likeRepo repositories.LikeRepository, postRepo repositories.PostRepository) *GetLikersUseCase {
+	return &GetLikersUseCase{
+		likeRepo: likeRepo,
+		postRepo: postRepo,
+	}
+}
+
+// Execute lists the users who liked postID, people viewerID follows first.
+func (uc *GetLikersUseCase) Execute(ctx context.Context, postID, viewerID uint, limit, offset int) ([]*models.User, error) {
+	ctx = repositories.WithViewer(ctx, viewerID)
+
+	if _, err := uc.postRepo.FindByID(ctx, postID); err != nil {
+		return nil, err
+	}
+
+	return uc.likeRepo.ListLikers(ctx, postID, viewerID, limit, offset)
+}
@@ -2,17 +2,29 @@ package like
 
 import (
 	"context"
+	"log"
 
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	infraStream "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/stream"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
 )
 
 type ToggleLikeUseCase struct {
-	likeRepo repositories.LikeRepository
+	likeRepo         repositories.LikeRepository
+	postRepo         repositories.PostRepository
+	notificationRepo repositories.NotificationRepository
+	txManager        repositories.TransactionManager
+	eventBroker      *infraStream.EventBroker
 }
 
-func NewToggleLikeUseCase(likeRepo repositories.LikeRepository) *ToggleLikeUseCase {
-	return &ToggleLikeUseCase{likeRepo: likeRepo}
+func NewToggleLikeUseCase(likeRepo repositories.LikeRepository, postRepo repositories.PostRepository, notificationRepo repositories.NotificationRepository, txManager repositories.TransactionManager, eventBroker *infraStream.EventBroker) *ToggleLikeUseCase {
+	return &ToggleLikeUseCase{
+		likeRepo:         likeRepo,
+		postRepo:         postRepo,
+		notificationRepo: notificationRepo,
+		txManager:        txManager,
+		eventBroker:      eventBroker,
+	}
 }
 
 type ToggleLikeOutput struct {
@@ -21,33 +33,85 @@ type ToggleLikeOutput struct {
 }
 
 func (uc *ToggleLikeUseCase) Execute(ctx context.Context, userID, postID uint) (*ToggleLikeOutput, error) {
-	exists, err := uc.likeRepo.Exists(ctx, userID, postID)
-	if err != nil {
-		return nil, err
-	}
+	var isLiked bool
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		exists, err := uc.likeRepo.Exists(ctx, userID, postID)
+		if err != nil {
+			return err
+		}
 
-	if exists {
-		if err := uc.likeRepo.Delete(ctx, userID, postID); err != nil {
-			return nil, err
+		if exists {
+			removed, err := uc.likeRepo.Delete(ctx, userID, postID)
+			if err != nil {
+				return err
+			}
+			isLiked = false
+			// A concurrent unlike may have removed it first
+			if !removed {
+				return nil
+			}
+			return uc.postRepo.IncrementCounter(ctx, postID, repositories.PostCounterLikes, -1)
 		}
-	} else {
+
+		// Posts hidden from the caller cannot be liked, only unliked
+		if _, err := uc.postRepo.FindByID(repositories.WithViewer(ctx, userID), postID); err != nil {
+			return err
+		}
+
 		like := &models.Like{
 			UserID: userID,
 			PostID: postID,
 		}
-		if err := uc.likeRepo.Create(ctx, like); err != nil {
-			return nil, err
+		created, err := uc.likeRepo.Create(ctx, like)
+		if err != nil {
+			return err
+		}
+		isLiked = true
+		// A concurrent like may have inserted it first
+		if !created {
+			return nil
 		}
+		if err := uc.postRepo.IncrementCounter(ctx, postID, repositories.PostCounterLikes, 1); err != nil {
+			return err
+		}
+
+		post, err := uc.postRepo.FindByID(ctx, postID)
+		if err != nil {
+			return err
+		}
+		if post.AuthorID == userID {
+			return nil
+		}
+		return uc.notificationRepo.Create(ctx, &models.Notification{
+			RecipientID: post.AuthorID,
+			ActorID:     userID,
+			Type:        models.NotificationTypeLike,
+			PostID:      &post.ID,
+		})
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Fetch updated count
-	count, err := uc.likeRepo.CountByPostID(ctx, postID)
+	post, err := uc.postRepo.FindByID(ctx, postID)
 	if err != nil {
 		return nil, err
 	}
 
+	// Notify connected clients; the like itself has already been saved
+	event := infraStream.Event{
+		Type:      infraStream.EventLikeCount,
+		PostID:    post.ID,
+		AuthorID:  post.AuthorID,
+		LikeCount: &post.LikeCount,
+	}
+	if err := uc.eventBroker.Publish(ctx, event); err != nil {
+		log.Printf("failed to publish like count for post %d: %v", post.ID, err)
+	}
+
 	return &ToggleLikeOutput{
-		IsLiked:   !exists,
-		LikeCount: count,
+		IsLiked:   isLiked,
+		LikeCount: post.LikeCount,
 	}, nil
 }
@@ -0,0 +1,136 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/imaging"
+	infraStorage "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/storage"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+// variantWidths are the srcset widths generated for each image. Widths at or
+// above the original's are skipped; clients use the original for those.
+var variantWidths = []int{320, 640, 1280}
+
+// BlurHash components; 4x3 suits the mostly landscape images in timelines
+const (
+	blurHashXComponents = 4
+	blurHashYComponents = 3
+)
+
+// errUnprocessable marks failures in the image itself, which no retry can
+// fix
+var errUnprocessable = errors.New("image cannot be processed")
+
+var variantExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
+
+type ProcessMediaUseCase struct {
+	mediaRepo repositories.MediaRepository
+	txManager repositories.TransactionManager
+	storage   infraStorage.MediaStorage
+}
+
+func NewProcessMediaUseCase(mediaRepo repositories.MediaRepository, txManager repositories.TransactionManager, storage infraStorage.MediaStorage) *ProcessMediaUseCase {
+	return &ProcessMediaUseCase{
+		mediaRepo: mediaRepo,
+		txManager: txManager,
+		storage:   storage,
+	}
+}
+
+// Execute builds the resized variants and BlurHash for an uploaded image.
+// Media that is no longer pending is skipped, and the result is only saved
+// while the row is still pending, so redelivered or re-enqueued jobs are
+// harmless even when two workers pick up the same media. Only an image that
+// cannot be decoded or encoded marks the media failed; storage errors leave
+// it pending for the sweeper to re-enqueue.
+func (uc *ProcessMediaUseCase) Execute(ctx context.Context, job imaging.ProcessingJob) error {
+	media, err := uc.mediaRepo.FindByID(ctx, job.MediaID)
+	if err != nil {
+		return err
+	}
+	if media.Status != models.MediaStatusPending {
+		return nil
+	}
+
+	variants, blurHash, err := uc.process(ctx, media)
+	if err != nil {
+		if !errors.Is(err, errUnprocessable) {
+			return err
+		}
+		if markErr := uc.mediaRepo.UpdateProcessing(ctx, media.ID, models.MediaStatusFailed, ""); markErr != nil {
+			log.Printf("failed to mark media %d as failed: %v", media.ID, markErr)
+		}
+		return err
+	}
+
+	return uc.txManager.Do(ctx, func(ctx context.Context) error {
+		locked, err := uc.mediaRepo.FindByIDForUpdate(ctx, media.ID)
+		if err != nil {
+			return err
+		}
+		// Another worker finished first; its variants used the same keys
+		if locked.Status != models.MediaStatusPending {
+			return nil
+		}
+		if err := uc.mediaRepo.CreateVariants(ctx, variants); err != nil {
+			return err
+		}
+		return uc.mediaRepo.UpdateProcessing(ctx, media.ID, models.MediaStatusReady, blurHash)
+	})
+}
+
+func (uc *ProcessMediaUseCase) process(ctx context.Context, media *models.Media) ([]models.MediaVariant, string, error) {
+	data, err := uc.storage.Get(ctx, media.StorageKey)
+	if err != nil {
+		return nil, "", err
+	}
+	img, err := imaging.Decode(data)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", errUnprocessable, err)
+	}
+
+	blurHash := imaging.BlurHash(img, blurHashXComponents, blurHashYComponents)
+
+	// Resizing would drop every frame but the first
+	if media.MimeType == "image/gif" {
+		return nil, blurHash, nil
+	}
+
+	base := strings.TrimSuffix(media.StorageKey, path.Ext(media.StorageKey))
+	var variants []models.MediaVariant
+	for _, width := range variantWidths {
+		if width >= img.Bounds().Dx() {
+			break
+		}
+
+		resized := imaging.Resize(img, width)
+		encoded, mimeType, err := imaging.EncodeVariant(resized)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %v", errUnprocessable, err)
+		}
+
+		key := fmt.Sprintf("%s_%dw%s", base, width, variantExtensions[mimeType])
+		if err := uc.storage.Put(ctx, key, encoded, mimeType); err != nil {
+			return nil, "", err
+		}
+		variants = append(variants, models.MediaVariant{
+			MediaID:    media.ID,
+			Width:      resized.Bounds().Dx(),
+			Height:     resized.Bounds().Dy(),
+			StorageKey: key,
+			URL:        uc.storage.URL(key),
+			MimeType:   mimeType,
+		})
+	}
+	return variants, blurHash, nil
+}
@@ -0,0 +1,43 @@
+package media
+
+import (
+	"context"
+	"time"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/imaging"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+// requeueBatchSize caps how many media one sweep re-enqueues
+const requeueBatchSize = 500
+
+// RequeueStaleMediaUseCase re-enqueues media that has been pending for longer
+// than staleAfter. That covers jobs that failed to enqueue and jobs lost when
+// a worker stopped mid-process.
+type RequeueStaleMediaUseCase struct {
+	mediaRepo       repositories.MediaRepository
+	processingQueue *imaging.ProcessingQueue
+	staleAfter      time.Duration
+}
+
+func NewRequeueStaleMediaUseCase(mediaRepo repositories.MediaRepository, processingQueue *imaging.ProcessingQueue, staleAfter time.Duration) *RequeueStaleMediaUseCase {
+	return &RequeueStaleMediaUseCase{
+		mediaRepo:       mediaRepo,
+		processingQueue: processingQueue,
+		staleAfter:      staleAfter,
+	}
+}
+
+// Execute returns the number of media re-enqueued.
+func (uc *RequeueStaleMediaUseCase) Execute(ctx context.Context) (int, error) {
+	ids, err := uc.mediaRepo.ListStalePending(ctx, time.Now().Add(-uc.staleAfter), requeueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for i, id := range ids {
+		if err := uc.processingQueue.Enqueue(ctx, imaging.ProcessingJob{MediaID: id}); err != nil {
+			return i, err
+		}
+	}
+	return len(ids), nil
+}
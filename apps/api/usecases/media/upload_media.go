@@ -0,0 +1,91 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/google/uuid"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/imaging"
+	infraStorage "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/storage"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+// allowedTypes maps accepted MIME types to the file extension used in keys
+var allowedTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+type UploadMediaUseCase struct {
+	mediaRepo       repositories.MediaRepository
+	storage         infraStorage.MediaStorage
+	processingQueue *imaging.ProcessingQueue
+}
+
+func NewUploadMediaUseCase(mediaRepo repositories.MediaRepository, storage infraStorage.MediaStorage, processingQueue *imaging.ProcessingQueue) *UploadMediaUseCase {
+	return &UploadMediaUseCase{
+		mediaRepo:       mediaRepo,
+		storage:         storage,
+		processingQueue: processingQueue,
+	}
+}
+
+// Execute validates an uploaded image by its content rather than the name or
+// header the client sent, strips its metadata and stores it.
+func (uc *UploadMediaUseCase) Execute(ctx context.Context, ownerID uint, data []byte) (*models.Media, error) {
+	if len(data) > models.MaxMediaSize {
+		return nil, domainErrors.ErrMediaTooLarge
+	}
+
+	mimeType := mimetype.Detect(data).String()
+	ext, ok := allowedTypes[mimeType]
+	if !ok {
+		return nil, domainErrors.ErrUnsupportedMedia
+	}
+
+	img, err := imaging.Sanitize(data, mimeType)
+	if err != nil {
+		if errors.Is(err, imaging.ErrUnsupportedFormat) || errors.Is(err, imaging.ErrTooManyPixels) {
+			return nil, fmt.Errorf("%w: %v", domainErrors.ErrUnsupportedMedia, err)
+		}
+		return nil, err
+	}
+
+	key := fmt.Sprintf("media/%d/%s%s", ownerID, uuid.NewString(), ext)
+	if err := uc.storage.Put(ctx, key, img.Data, mimeType); err != nil {
+		return nil, err
+	}
+
+	media := &models.Media{
+		OwnerID:    ownerID,
+		StorageKey: key,
+		URL:        uc.storage.URL(key),
+		MimeType:   mimeType,
+		Size:       int64(len(img.Data)),
+		Width:      img.Width,
+		Height:     img.Height,
+		Status:     models.MediaStatusPending,
+	}
+	if err := uc.mediaRepo.Create(ctx, media); err != nil {
+		// Don't leave an unreferenced file behind
+		if delErr := uc.storage.Delete(ctx, key); delErr != nil {
+			log.Printf("failed to delete orphaned media %s: %v", key, delErr)
+		}
+		return nil, err
+	}
+
+	// Variants are optional and the original is usable meanwhile. A job that
+	// fails to enqueue is picked up by RequeueStaleMediaUseCase later.
+	if err := uc.processingQueue.Enqueue(ctx, imaging.ProcessingJob{MediaID: media.ID}); err != nil {
+		log.Printf("failed to enqueue processing for media %d: %v", media.ID, err)
+	}
+
+	return media, nil
+}
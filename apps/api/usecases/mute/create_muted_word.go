@@ -0,0 +1,55 @@
+package mute
+
+import (
+	"context"
+	"time"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/text"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type CreateMutedWordUseCase struct {
+	mutedWordRepo repositories.MutedWordRepository
+}
+
+func NewCreateMutedWordUseCase(mutedWordRepo repositories.MutedWordRepository) *CreateMutedWordUseCase {
+	return &CreateMutedWordUseCase{mutedWordRepo: mutedWordRepo}
+}
+
+type CreateMutedWordInput struct {
+	UserID          uint
+	Word            string
+	NonFollowedOnly bool
+	// ExpiresIn mutes the word for a set duration; zero mutes it indefinitely
+	ExpiresIn time.Duration
+}
+
+func (uc *CreateMutedWordUseCase) Execute(ctx context.Context, input CreateMutedWordInput) (*models.MutedWord, error) {
+	word, ok := text.NormalizeMutedWord(input.Word)
+	if !ok || input.ExpiresIn < 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	mutedWord := &models.MutedWord{
+		UserID:          input.UserID,
+		Word:            word,
+		NonFollowedOnly: input.NonFollowedOnly,
+		ExpiresAt:       expiresAt(input.ExpiresIn),
+	}
+	if err := uc.mutedWordRepo.Create(ctx, mutedWord); err != nil {
+		return nil, err
+	}
+	return mutedWord, nil
+}
+
+// expiresAt turns a mute duration into an expiry time, with zero meaning
+// no expiry.
+func expiresAt(d time.Duration) *time.Time {
+	if d == 0 {
+		return nil
+	}
+	t := time.Now().Add(d)
+	return &t
+}
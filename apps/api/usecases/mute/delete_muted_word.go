@@ -0,0 +1,19 @@
+package mute
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type DeleteMutedWordUseCase struct {
+	mutedWordRepo repositories.MutedWordRepository
+}
+
+func NewDeleteMutedWordUseCase(mutedWordRepo repositories.MutedWordRepository) *DeleteMutedWordUseCase {
+	return &DeleteMutedWordUseCase{mutedWordRepo: mutedWordRepo}
+}
+
+func (uc *DeleteMutedWordUseCase) Execute(ctx context.Context, userID, id uint) error {
+	return uc.mutedWordRepo.Delete(ctx, userID, id)
+}
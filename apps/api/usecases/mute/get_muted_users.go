@@ -0,0 +1,20 @@
+package mute
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type GetMutedUsersUseCase struct {
+	muteRepo repositories.MuteRepository
+}
+
+func NewGetMutedUsersUseCase(muteRepo repositories.MuteRepository) *GetMutedUsersUseCase {
+	return &GetMutedUsersUseCase{muteRepo: muteRepo}
+}
+
+func (uc *GetMutedUsersUseCase) Execute(ctx context.Context, userID uint, limit, offset int) ([]*models.User, error) {
+	return uc.muteRepo.ListMuted(ctx, userID, limit, offset)
+}
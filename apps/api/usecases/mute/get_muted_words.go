@@ -0,0 +1,22 @@
+package mute
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type GetMutedWordsUseCase struct {
+	mutedWordRepo repositories.MutedWordRepository
+}
+
+func NewGetMutedWordsUseCase(mutedWordRepo repositories.MutedWordRepository) *GetMutedWordsUseCase {
+	return &GetMutedWordsUseCase{mutedWordRepo: mutedWordRepo}
+}
+
+// Execute lists all of the user's muted words, expired ones included, newest
+// first.
+func (uc *GetMutedWordsUseCase) Execute(ctx context.Context, userID uint) ([]*models.MutedWord, error) {
+	return uc.mutedWordRepo.ListByUser(ctx, userID)
+}
@@ -0,0 +1,44 @@
+package mute
+
+import (
+	"context"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type MuteUserUseCase struct {
+	muteRepo repositories.MuteRepository
+	userRepo repositories.UserRepository
+}
+
+func NewMuteUserUseCase(muteRepo repositories.MuteRepository, userRepo repositories.UserRepository) *MuteUserUseCase {
+	return &MuteUserUseCase{
+		muteRepo: muteRepo,
+		userRepo: userRepo,
+	}
+}
+
+type MuteOutput struct {
+	IsMuting bool `json:"is_muting"`
+}
+
+// Execute mutes username for muterID. Cached timelines need no rebuild since
+// muted posts are filtered when they are read.
+func (uc *MuteUserUseCase) Execute(ctx context.Context, muterID uint, username string) (*MuteOutput, error) {
+	target, err := uc.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users cannot mute themselves
+	if target.ID == muterID {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	if err := uc.muteRepo.Create(ctx, &models.Mute{MuterID: muterID, MutedID: target.ID}); err != nil {
+		return nil, err
+	}
+	return &MuteOutput{IsMuting: true}, nil
+}
@@ -0,0 +1,42 @@
+package mute
+
+import (
+	"context"
+	"time"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+// MutedWordLoader builds a viewer's muted word filter for the read use cases
+// that apply it. Followees are only loaded when one of the words is limited
+// to non-followed accounts.
+type MutedWordLoader struct {
+	mutedWordRepo repositories.MutedWordRepository
+	followRepo    repositories.FollowRepository
+}
+
+func NewMutedWordLoader(mutedWordRepo repositories.MutedWordRepository, followRepo repositories.FollowRepository) *MutedWordLoader {
+	return &MutedWordLoader{
+		mutedWordRepo: mutedWordRepo,
+		followRepo:    followRepo,
+	}
+}
+
+func (l *MutedWordLoader) Load(ctx context.Context, userID uint) (*models.MutedWordFilter, error) {
+	words, err := l.mutedWordRepo.ListActive(ctx, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var followeeIDs []uint
+	for _, w := range words {
+		if w.NonFollowedOnly {
+			if followeeIDs, err = l.followRepo.ListFolloweeIDs(ctx, userID); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return models.NewMutedWordFilter(userID, words, followeeIDs), nil
+}
@@ -0,0 +1,31 @@
+package mute
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type UnmuteUserUseCase struct {
+	muteRepo repositories.MuteRepository
+	userRepo repositories.UserRepository
+}
+
+func NewUnmuteUserUseCase(muteRepo repositories.MuteRepository, userRepo repositories.UserRepository) *UnmuteUserUseCase {
+	return &UnmuteUserUseCase{
+		muteRepo: muteRepo,
+		userRepo: userRepo,
+	}
+}
+
+func (uc *UnmuteUserUseCase) Execute(ctx context.Context, muterID uint, username string) (*MuteOutput, error) {
+	target, err := uc.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.muteRepo.Delete(ctx, muterID, target.ID); err != nil {
+		return nil, err
+	}
+	return &MuteOutput{IsMuting: false}, nil
+}
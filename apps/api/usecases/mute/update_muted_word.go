@@ -0,0 +1,51 @@
+package mute
+
+import (
+	"context"
+	"time"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type UpdateMutedWordUseCase struct {
+	mutedWordRepo repositories.MutedWordRepository
+}
+
+func NewUpdateMutedWordUseCase(mutedWordRepo repositories.MutedWordRepository) *UpdateMutedWordUseCase {
+	return &UpdateMutedWordUseCase{mutedWordRepo: mutedWordRepo}
+}
+
+// UpdateMutedWordInput changes the options of a muted word. Nil fields are
+// left as they are. The word itself cannot change; mute a new one instead.
+type UpdateMutedWordInput struct {
+	UserID          uint
+	ID              uint
+	NonFollowedOnly *bool
+	// ExpiresIn restarts the mute for a set duration from now; zero makes it
+	// indefinite
+	ExpiresIn *time.Duration
+}
+
+func (uc *UpdateMutedWordUseCase) Execute(ctx context.Context, input UpdateMutedWordInput) (*models.MutedWord, error) {
+	if input.ExpiresIn != nil && *input.ExpiresIn < 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	mutedWord, err := uc.mutedWordRepo.FindByID(ctx, input.UserID, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if input.NonFollowedOnly != nil {
+		mutedWord.NonFollowedOnly = *input.NonFollowedOnly
+	}
+	if input.ExpiresIn != nil {
+		mutedWord.ExpiresAt = expiresAt(*input.ExpiresIn)
+	}
+
+	if err := uc.mutedWordRepo.Update(ctx, mutedWord); err != nil {
+		return nil, err
+	}
+	return mutedWord, nil
+}
@@ -0,0 +1,63 @@
+package notification
+
+import (
+	"context"
+	"slices"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/mute"
+)
+
+type GetNotificationsUseCase struct {
+	notificationRepo repositories.NotificationRepository
+	mutedWords       *mute.MutedWordLoader
+}
+
+func NewGetNotificationsUseCase(notificationRepo repositories.NotificationRepository, mutedWordRepo repositories.MutedWordRepository, followRepo repositories.FollowRepository) *GetNotificationsUseCase {
+	return &GetNotificationsUseCase{
+		notificationRepo: notificationRepo,
+		mutedWords:       mute.NewMutedWordLoader(mutedWordRepo, followRepo),
+	}
+}
+
+type GetNotificationsOutput struct {
+	Groups      []*models.NotificationGroup
+	UnreadCount int64
+}
+
+func (uc *GetNotificationsUseCase) Execute(ctx context.Context, userID uint, limit, offset int) (*GetNotificationsOutput, error) {
+	groups, err := uc.notificationRepo.ListGroups(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := uc.mutedWords.Load(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	groups = dropMutedWords(filter, groups)
+
+	unread, err := countUnread(ctx, uc.notificationRepo, filter, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetNotificationsOutput{
+		Groups:      groups,
+		UnreadCount: unread,
+	}, nil
+}
+
+// dropMutedWords removes notifications of the MutableNotificationTypes whose
+// post contains one of the user's muted words.
+func dropMutedWords(filter *models.MutedWordFilter, groups []*models.NotificationGroup) []*models.NotificationGroup {
+	kept := groups[:0]
+	for _, g := range groups {
+		if slices.Contains(models.MutableNotificationTypes, g.Type) && g.Post != nil && filter.Hides(g.Post) {
+			continue
+		}
+		kept = append(kept, g)
+	}
+	return kept
+}
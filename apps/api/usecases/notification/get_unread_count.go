@@ -0,0 +1,28 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/mute"
+)
+
+type GetUnreadCountUseCase struct {
+	notificationRepo repositories.NotificationRepository
+	mutedWords       *mute.MutedWordLoader
+}
+
+func NewGetUnreadCountUseCase(notificationRepo repositories.NotificationRepository, mutedWordRepo repositories.MutedWordRepository, followRepo repositories.FollowRepository) *GetUnreadCountUseCase {
+	return &GetUnreadCountUseCase{
+		notificationRepo: notificationRepo,
+		mutedWords:       mute.NewMutedWordLoader(mutedWordRepo, followRepo),
+	}
+}
+
+func (uc *GetUnreadCountUseCase) Execute(ctx context.Context, userID uint) (int64, error) {
+	filter, err := uc.mutedWords.Load(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return countUnread(ctx, uc.notificationRepo, filter, userID)
+}
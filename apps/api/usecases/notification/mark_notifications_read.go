@@ -0,0 +1,21 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type MarkNotificationsReadUseCase struct {
+	notificationRepo repositories.NotificationRepository
+}
+
+func NewMarkNotificationsReadUseCase(notificationRepo repositories.NotificationRepository) *MarkNotificationsReadUseCase {
+	return &MarkNotificationsReadUseCase{
+		notificationRepo: notificationRepo,
+	}
+}
+
+func (uc *MarkNotificationsReadUseCase) Execute(ctx context.Context, userID uint) error {
+	return uc.notificationRepo.MarkAllRead(ctx, userID)
+}
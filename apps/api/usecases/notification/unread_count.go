@@ -0,0 +1,26 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+// countUnread counts the unread notifications the list would show, so the
+// badge never promises items that muted words hide.
+func countUnread(ctx context.Context, notificationRepo repositories.NotificationRepository, filter *models.MutedWordFilter, userID uint) (int64, error) {
+	var hiddenPostIDs []uint
+	if !filter.Empty() {
+		posts, err := notificationRepo.ListUnreadPosts(ctx, userID)
+		if err != nil {
+			return 0, err
+		}
+		for _, p := range posts {
+			if filter.Hides(p) {
+				hiddenPostIDs = append(hiddenPostIDs, p.ID)
+			}
+		}
+	}
+	return notificationRepo.CountUnread(ctx, userID, hiddenPostIDs)
+}
@@ -2,22 +2,41 @@ package post
 
 import (
 	"context"
+	"log"
 	"unicode/utf8"
 
 	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/text"
+	infraStream "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/stream"
+	infraTimeline "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/timeline"
+	infraTrending "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/trending"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
 )
 
 type CreatePostUseCase struct {
-	postRepo repositories.PostRepository
-	userRepo repositories.UserRepository
+	postRepo         repositories.PostRepository
+	userRepo         repositories.UserRepository
+	notificationRepo repositories.NotificationRepository
+	hashtagRepo      repositories.HashtagRepository
+	mediaRepo        repositories.MediaRepository
+	txManager        repositories.TransactionManager
+	fanoutQueue      *infraTimeline.FanoutQueue
+	eventBroker      *infraStream.EventBroker
+	hashtagTracker   *infraTrending.HashtagTracker
 }
 
-func NewCreatePostUseCase(postRepo repositories.PostRepository, userRepo repositories.UserRepository) *CreatePostUseCase {
+func NewCreatePostUseCase(postRepo repositories.PostRepository, userRepo repositories.UserRepository, notificationRepo repositories.NotificationRepository, hashtagRepo repositories.HashtagRepository, mediaRepo repositories.MediaRepository, txManager repositories.TransactionManager, fanoutQueue *infraTimeline.FanoutQueue, eventBroker *infraStream.EventBroker, hashtagTracker *infraTrending.HashtagTracker) *CreatePostUseCase {
 	return &CreatePostUseCase{
-		postRepo: postRepo,
-		userRepo: userRepo,
+		postRepo:         postRepo,
+		userRepo:         userRepo,
+		notificationRepo: notificationRepo,
+		hashtagRepo:      hashtagRepo,
+		mediaRepo:        mediaRepo,
+		txManager:        txManager,
+		fanoutQueue:      fanoutQueue,
+		eventBroker:      eventBroker,
+		hashtagTracker:   hashtagTracker,
 	}
 }
 
@@ -26,6 +45,9 @@ type CreatePostInput struct {
 	AuthorID uint
 	ParentID *uint
 	RepostID *uint
+	MediaIDs []uint
+	// ReplyPolicy limits who may reply; empty means everyone
+	ReplyPolicy string
 }
 
 type CreatePostOutput struct {
@@ -33,25 +55,148 @@ type CreatePostOutput struct {
 }
 
 func (uc *CreatePostUseCase) Execute(ctx context.Context, input CreatePostInput) (*CreatePostOutput, error) {
+	// Posts hidden from the author cannot be replied to, reposted or quoted
+	ctx = repositories.WithViewer(ctx, input.AuthorID)
+
 	// Validation
-	if input.RepostID == nil && input.Content == "" {
+	if input.RepostID == nil && input.Content == "" && len(input.MediaIDs) == 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+	if len(input.MediaIDs) > models.MaxMediaPerPost {
 		return nil, domainErrors.ErrInvalidInput
 	}
 	if utf8.RuneCountInString(input.Content) > 140 {
 		return nil, domainErrors.ErrInvalidInput
 	}
+	replyPolicy := input.ReplyPolicy
+	if replyPolicy == "" {
+		replyPolicy = models.ReplyPolicyEveryone
+	}
+	if !models.ValidReplyPolicy(replyPolicy) {
+		return nil, domainErrors.ErrInvalidInput
+	}
 
 	post := &models.Post{
-		Content:  input.Content,
-		AuthorID: input.AuthorID,
-		ParentID: input.ParentID,
-		RepostID: input.RepostID,
+		Content:     input.Content,
+		Kind:        models.PostKindOf(input.ParentID, input.RepostID, input.Content != "" || len(input.MediaIDs) > 0),
+		AuthorID:    input.AuthorID,
+		ParentID:    input.ParentID,
+		RepostID:    input.RepostID,
+		ReplyPolicy: replyPolicy,
+		// Authors can always reply to their own posts
+		CanReply: true,
 	}
 
-	if err := uc.postRepo.Create(ctx, post); err != nil {
+	tags := text.UniqueTags(text.ExtractHashtags(input.Content))
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		mentions, err := resolveMentions(ctx, uc.userRepo, input.Content)
+		if err != nil {
+			return err
+		}
+		post.Mentions = mentions
+
+		// Referenced posts must exist and not be deleted
+		if input.ParentID != nil {
+			parent, err := uc.postRepo.FindByID(ctx, *input.ParentID)
+			if err != nil {
+				return err
+			}
+			allowed, err := uc.postRepo.FindRepliablePostIDs(ctx, input.AuthorID, []uint{parent.ID})
+			if err != nil {
+				return err
+			}
+			if !allowed[parent.ID] {
+				return domainErrors.ErrReplyNotAllowed
+			}
+			// Replies join their parent's conversation; anything else starts one
+			post.ConversationID = parent.ConversationID
+		}
+		if input.RepostID != nil {
+			target, err := uc.postRepo.FindByID(ctx, *input.RepostID)
+			if err != nil {
+				return err
+			}
+			// Reposting or quoting a plain repost targets the original
+			for target.Kind == models.PostKindRepost && target.RepostID != nil {
+				if target, err = uc.postRepo.FindByID(ctx, *target.RepostID); err != nil {
+					return err
+				}
+			}
+			// Protected posts stay with the author's approved followers, though
+			// the author may still repost or quote their own
+			if target.AuthorID != input.AuthorID {
+				author, err := uc.userRepo.FindByID(ctx, target.AuthorID)
+				if err != nil {
+					return err
+				}
+				if author.IsPrivate {
+					return domainErrors.ErrProtectedPost
+				}
+			}
+			post.RepostID = &target.ID
+		}
+
+		if err := uc.postRepo.Create(ctx, post); err != nil {
+			return err
+		}
+		if err := uc.hashtagRepo.AttachToPost(ctx, post.ID, post.CreatedAt, tags); err != nil {
+			return err
+		}
+		if len(input.MediaIDs) > 0 {
+			if err := uc.mediaRepo.AttachToPost(ctx, post.ID, post.AuthorID, input.MediaIDs); err != nil {
+				return err
+			}
+		}
+
+		// Keep the referenced post's counters in sync
+		if input.ParentID != nil {
+			if err := uc.postRepo.IncrementCounter(ctx, *input.ParentID, repositories.PostCounterReplies, 1); err != nil {
+				return err
+			}
+		}
+		if post.RepostID != nil {
+			if err := uc.postRepo.IncrementCounter(ctx, *post.RepostID, embedCounter(post), 1); err != nil {
+				return err
+			}
+		}
+		return uc.notify(ctx, post)
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	// Push top-level posts and reposts into followers' cached timelines.
+	// The post is already saved, so a queue failure only delays delivery
+	// until the affected timelines are rebuilt.
+	if post.ParentID == nil {
+		job := infraTimeline.FanoutJob{
+			PostID:    post.ID,
+			AuthorID:  post.AuthorID,
+			CreatedAt: post.CreatedAt,
+		}
+		if err := uc.fanoutQueue.Enqueue(ctx, job); err != nil {
+			log.Printf("failed to enqueue fanout for post %d: %v", post.ID, err)
+		}
+	}
+
+	uc.publishEvents(ctx, post)
+
+	// Trending counts are approximate; a missed use does not warrant failing
+	if err := uc.hashtagTracker.Record(ctx, tags, post.CreatedAt); err != nil {
+		log.Printf("failed to record hashtags for post %d: %v", post.ID, err)
+	}
+
+	// Fetch attached media in order
+	if len(input.MediaIDs) > 0 {
+		media, err := uc.mediaRepo.ListByPostID(ctx, post.ID)
+		if err == nil {
+			for _, m := range media {
+				post.Media = append(post.Media, *m)
+			}
+		}
+	}
+
 	// Fetch Author details
 	author, err := uc.userRepo.FindByID(ctx, input.AuthorID)
 	if err == nil {
@@ -59,8 +204,8 @@ func (uc *CreatePostUseCase) Execute(ctx context.Context, input CreatePostInput)
 	}
 
 	// Fetch Repost details if this is a repost
-	if input.RepostID != nil {
-		repostData, err := uc.postRepo.FindByID(ctx, *input.RepostID)
+	if post.RepostID != nil {
+		repostData, err := uc.postRepo.FindByID(ctx, *post.RepostID)
 		if err == nil {
 			// Fetch repost author
 			repostAuthor, err := uc.userRepo.FindByID(ctx, repostData.AuthorID)
@@ -73,3 +218,92 @@ func (uc *CreatePostUseCase) Execute(ctx context.Context, input CreatePostInput)
 
 	return &CreatePostOutput{Post: post}, nil
 }
+
+// notify creates notifications for the author of the replied-to or reposted
+// post and for every user mentioned in the content. Nobody is notified about
+// their own activity, and each user gets at most one notification per post.
+func (uc *CreatePostUseCase) notify(ctx context.Context, post *models.Post) error {
+	notified := map[uint]bool{post.AuthorID: true}
+	create := func(recipientID uint, notificationType string, postID uint) error {
+		if notified[recipientID] {
+			return nil
+		}
+		notified[recipientID] = true
+		return uc.notificationRepo.Create(ctx, &models.Notification{
+			RecipientID: recipientID,
+			ActorID:     post.AuthorID,
+			Type:        notificationType,
+			PostID:      &postID,
+		})
+	}
+
+	if post.ParentID != nil {
+		parent, err := uc.postRepo.FindByID(ctx, *post.ParentID)
+		if err != nil {
+			return err
+		}
+		if err := create(parent.AuthorID, models.NotificationTypeReply, post.ID); err != nil {
+			return err
+		}
+	}
+	if post.RepostID != nil {
+		original, err := uc.postRepo.FindByID(ctx, *post.RepostID)
+		if err != nil {
+			return err
+		}
+		// Reposts are grouped on the original post; each quote stands alone
+		if post.Kind == models.PostKindRepost {
+			err = create(original.AuthorID, models.NotificationTypeRepost, original.ID)
+		} else {
+			err = create(original.AuthorID, models.NotificationTypeQuote, post.ID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, mention := range post.Mentions {
+		if err := create(mention.UserID, models.NotificationTypeMention, post.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// embedCounter is the counter on the embedded post that post counts toward:
+// repost_count for plain reposts and quote_count for anything with content.
+func embedCounter(post *models.Post) repositories.PostCounter {
+	if post.Kind == models.PostKindRepost {
+		return repositories.PostCounterReposts
+	}
+	return repositories.PostCounterQuotes
+}
+
+// publishEvents notifies connected clients about the new post, or about the
+// parent's new reply count for replies. Failures are logged and otherwise
+// ignored since the post is already saved.
+func (uc *CreatePostUseCase) publishEvents(ctx context.Context, post *models.Post) {
+	event := infraStream.Event{
+		Type:     infraStream.EventNewPost,
+		PostID:   post.ID,
+		AuthorID: post.AuthorID,
+	}
+
+	if post.ParentID != nil {
+		parent, err := uc.postRepo.FindByID(ctx, *post.ParentID)
+		if err != nil {
+			log.Printf("failed to load parent post %d: %v", *post.ParentID, err)
+			return
+		}
+		event = infraStream.Event{
+			Type:       infraStream.EventReplyCount,
+			PostID:     parent.ID,
+			AuthorID:   parent.AuthorID,
+			ReplyCount: &parent.ReplyCount,
+		}
+	}
+
+	if err := uc.eventBroker.Publish(ctx, event); err != nil {
+		log.Printf("failed to publish event for post %d: %v", post.ID, err)
+	}
+}
@@ -8,27 +8,54 @@ import (
 )
 
 type DeletePostUseCase struct {
-	postRepo repositories.PostRepository
+	postRepo  repositories.PostRepository
+	txManager repositories.TransactionManager
 }
 
-func NewDeletePostUseCase(postRepo repositories.PostRepository) *DeletePostUseCase {
+func NewDeletePostUseCase(postRepo repositories.PostRepository, txManager repositories.TransactionManager) *DeletePostUseCase {
 	return &DeletePostUseCase{
-		postRepo: postRepo,
+		postRepo:  postRepo,
+		txManager: txManager,
 	}
 }
 
+// Execute soft-deletes the post. The row stays behind as a tombstone so
+// replies and reposts keep pointing at something; PurgeDeletedPostsUseCase
+// removes its content and media once the retention period has passed.
 func (uc *DeletePostUseCase) Execute(ctx context.Context, postID uint, userID uint) error {
-	// Check if post exists
-	post, err := uc.postRepo.FindByID(ctx, postID)
-	if err != nil {
-		return err
-	}
+	return uc.txManager.Do(ctx, func(ctx context.Context) error {
+		// Check if post exists
+		post, err := uc.postRepo.FindByID(ctx, postID)
+		if err != nil {
+			return err
+		}
 
-	// Check if user is the author
-	if post.AuthorID != userID {
-		return domainErrors.ErrUnauthorized
-	}
+		// Check if user is the author
+		if post.AuthorID != userID {
+			return domainErrors.ErrUnauthorized
+		}
+
+		// Delete post. Only the request that actually deleted it adjusts the
+		// counters, in case two deletes race.
+		deleted, err := uc.postRepo.Delete(ctx, postID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return nil
+		}
 
-	// Delete post
-	return uc.postRepo.Delete(ctx, postID)
+		// Keep the referenced post's counters in sync
+		if post.ParentID != nil {
+			if err := uc.postRepo.IncrementCounter(ctx, *post.ParentID, repositories.PostCounterReplies, -1); err != nil {
+				return err
+			}
+		}
+		if post.RepostID != nil {
+			if err := uc.postRepo.IncrementCounter(ctx, *post.RepostID, embedCounter(post), -1); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
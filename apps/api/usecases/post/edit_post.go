@@ -0,0 +1,163 @@
+package post
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/text"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type EditPostUseCase struct {
+	postRepo         repositories.PostRepository
+	userRepo         repositories.UserRepository
+	revisionRepo     repositories.PostRevisionRepository
+	notificationRepo repositories.NotificationRepository
+	hashtagRepo      repositories.HashtagRepository
+	mediaRepo        repositories.MediaRepository
+	txManager        repositories.TransactionManager
+	engagement       *engagementLoader
+	editWindow       time.Duration
+}
+
+func NewEditPostUseCase(postRepo repositories.PostRepository, userRepo repositories.UserRepository, likeRepo repositories.LikeRepository, bookmarkRepo repositories.BookmarkRepository, revisionRepo repositories.PostRevisionRepository, notificationRepo repositories.NotificationRepository, hashtagRepo repositories.HashtagRepository, mediaRepo repositories.MediaRepository, txManager repositories.TransactionManager, editWindow time.Duration) *EditPostUseCase {
+	return &EditPostUseCase{
+		postRepo:         postRepo,
+		userRepo:         userRepo,
+		revisionRepo:     revisionRepo,
+		notificationRepo: notificationRepo,
+		hashtagRepo:      hashtagRepo,
+		mediaRepo:        mediaRepo,
+		txManager:        txManager,
+		engagement:       newEngagementLoader(postRepo, likeRepo, bookmarkRepo),
+		editWindow:       editWindow,
+	}
+}
+
+type EditPostInput struct {
+	PostID  uint
+	UserID  uint
+	Content string
+}
+
+// Execute replaces a post's content, keeping the previous version as a
+// revision. Only the author may edit, and only within the edit window.
+func (uc *EditPostUseCase) Execute(ctx context.Context, input EditPostInput) (*models.Post, error) {
+	if utf8.RuneCountInString(input.Content) > 140 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		// Lock the row so concurrent edits produce one revision each
+		post, err := uc.postRepo.FindByIDForUpdate(ctx, input.PostID)
+		if err != nil {
+			return err
+		}
+		if post.AuthorID != input.UserID {
+			return domainErrors.ErrUnauthorized
+		}
+		if time.Since(post.CreatedAt) > uc.editWindow {
+			return domainErrors.ErrEditWindowExpired
+		}
+		if err := uc.validate(ctx, post, input.Content); err != nil {
+			return err
+		}
+		if post.Content == input.Content {
+			return nil
+		}
+
+		versionTime := post.CreatedAt
+		if post.EditedAt != nil {
+			versionTime = *post.EditedAt
+		}
+		revision := &models.PostRevision{
+			PostID:    post.ID,
+			Content:   post.Content,
+			CreatedAt: versionTime,
+		}
+		if err := uc.revisionRepo.Create(ctx, revision); err != nil {
+			return err
+		}
+		if err := uc.postRepo.UpdateContent(ctx, post.ID, input.Content, time.Now()); err != nil {
+			return err
+		}
+
+		// Mentions and hashtags follow the new content
+		mentions, err := resolveMentions(ctx, uc.userRepo, input.Content)
+		if err != nil {
+			return err
+		}
+		if err := uc.postRepo.ReplaceMentions(ctx, post.ID, mentions); err != nil {
+			return err
+		}
+		if err := uc.notifyMentions(ctx, post, mentions); err != nil {
+			return err
+		}
+
+		if err := uc.hashtagRepo.DetachFromPost(ctx, post.ID); err != nil {
+			return err
+		}
+		tags := text.UniqueTags(text.ExtractHashtags(input.Content))
+		return uc.hashtagRepo.AttachToPost(ctx, post.ID, post.CreatedAt, tags)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := uc.postRepo.FindByIDs(ctx, []uint{input.PostID})
+	if err != nil {
+		return nil, err
+	}
+	if len(posts) == 0 {
+		return nil, domainErrors.ErrPostNotFound
+	}
+
+	// Populate IsLiked, IsBookmarked and IsReposted
+	if err := uc.engagement.Populate(ctx, input.UserID, posts); err != nil {
+		return nil, err
+	}
+	return posts[0], nil
+}
+
+// validate applies the creation rules to the new content: plain reposts have
+// no content to edit, and other posts need content unless they carry media.
+func (uc *EditPostUseCase) validate(ctx context.Context, post *models.Post, content string) error {
+	if post.Kind == models.PostKindRepost {
+		return domainErrors.ErrInvalidInput
+	}
+	if content != "" {
+		return nil
+	}
+
+	media, err := uc.mediaRepo.ListByPostID(ctx, post.ID)
+	if err != nil {
+		return err
+	}
+	if len(media) == 0 {
+		return domainErrors.ErrInvalidInput
+	}
+	return nil
+}
+
+// notifyMentions notifies users mentioned by the edit. Users who were
+// already mentioned are not notified again.
+func (uc *EditPostUseCase) notifyMentions(ctx context.Context, post *models.Post, mentions []models.PostMention) error {
+	for _, mention := range mentions {
+		if mention.UserID == post.AuthorID {
+			continue
+		}
+		err := uc.notificationRepo.Create(ctx, &models.Notification{
+			RecipientID: mention.UserID,
+			ActorID:     post.AuthorID,
+			Type:        models.NotificationTypeMention,
+			PostID:      &post.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
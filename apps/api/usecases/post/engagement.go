@@ -0,0 +1,77 @@
+package post
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+// engagementLoader fills in the viewer's like, bookmark and repost status on
+// a page of posts. Engagement counts are stored on the posts themselves.
+// Every call costs a fixed number of queries regardless of how many posts or
+// embedded reposts the page contains.
+type engagementLoader struct {
+	postRepo     repositories.PostRepository
+	likeRepo     repositories.LikeRepository
+	bookmarkRepo repositories.BookmarkRepository
+}
+
+func newEngagementLoader(postRepo repositories.PostRepository, likeRepo repositories.LikeRepository, bookmarkRepo repositories.BookmarkRepository) *engagementLoader {
+	return &engagementLoader{
+		postRepo:     postRepo,
+		likeRepo:     likeRepo,
+		bookmarkRepo: bookmarkRepo,
+	}
+}
+
+// Populate sets the viewer's like/bookmark/repost status, and whether they
+// may reply, on posts and on any embedded reposts.
+func (l *engagementLoader) Populate(ctx context.Context, userID uint, posts []*models.Post) error {
+	targets := collectPosts(posts)
+	if len(targets) == 0 {
+		return nil
+	}
+
+	postIDs := make([]uint, 0, len(targets))
+	for _, p := range targets {
+		postIDs = append(postIDs, p.ID)
+	}
+
+	liked, err := l.likeRepo.FindPostIDsByUser(ctx, userID, postIDs)
+	if err != nil {
+		return err
+	}
+	bookmarked, err := l.bookmarkRepo.FindPostIDsByUser(ctx, userID, postIDs)
+	if err != nil {
+		return err
+	}
+	reposted, err := l.postRepo.FindRepostedPostIDs(ctx, userID, postIDs)
+	if err != nil {
+		return err
+	}
+	repliable, err := l.postRepo.FindRepliablePostIDs(ctx, userID, postIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range targets {
+		p.IsLiked = liked[p.ID]
+		p.IsBookmarked = bookmarked[p.ID]
+		p.IsReposted = reposted[p.ID]
+		p.CanReply = repliable[p.ID]
+	}
+	return nil
+}
+
+// collectPosts flattens posts and their embedded reposts into one slice.
+func collectPosts(posts []*models.Post) []*models.Post {
+	targets := make([]*models.Post, 0, len(posts)*2)
+	for _, p := range posts {
+		targets = append(targets, p)
+		if p.Repost != nil {
+			targets = append(targets, p.Repost)
+		}
+	}
+	return targets
+}
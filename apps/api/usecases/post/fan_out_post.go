@@ -0,0 +1,70 @@
+package post
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	infraTimeline "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/timeline"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+const fanoutBatchSize = 1000
+
+// FanOutPostUseCase pushes a new post into the cached home timelines of the
+// author and their followers. Accounts with at least celebrityThreshold
+// followers are skipped; their posts are merged in at read time instead.
+type FanOutPostUseCase struct {
+	followRepo         repositories.FollowRepository
+	userRepo           repositories.UserRepository
+	timelineCache      *infraTimeline.TimelineCache
+	celebrityThreshold int64
+}
+
+func NewFanOutPostUseCase(followRepo repositories.FollowRepository, userRepo repositories.UserRepository, timelineCache *infraTimeline.TimelineCache, celebrityThreshold int64) *FanOutPostUseCase {
+	return &FanOutPostUseCase{
+		followRepo:         followRepo,
+		userRepo:           userRepo,
+		timelineCache:      timelineCache,
+		celebrityThreshold: celebrityThreshold,
+	}
+}
+
+func (uc *FanOutPostUseCase) Execute(ctx context.Context, job infraTimeline.FanoutJob) error {
+	entry := models.TimelineEntry{
+		PostID:    job.PostID,
+		CreatedAt: job.CreatedAt,
+	}
+
+	// Authors always see their own posts
+	if err := uc.timelineCache.Push(ctx, []uint{job.AuthorID}, entry); err != nil {
+		return err
+	}
+
+	author, err := uc.userRepo.FindByID(ctx, job.AuthorID)
+	if err != nil {
+		return err
+	}
+	if author.FollowerCount >= uc.celebrityThreshold {
+		return nil
+	}
+
+	var afterID uint
+	for {
+		followerIDs, err := uc.followRepo.ListFollowerIDs(ctx, job.AuthorID, afterID, fanoutBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(followerIDs) == 0 {
+			return nil
+		}
+
+		if err := uc.timelineCache.Push(ctx, followerIDs, entry); err != nil {
+			return err
+		}
+
+		if len(followerIDs) < fanoutBatchSize {
+			return nil
+		}
+		afterID = followerIDs[len(followerIDs)-1]
+	}
+}
@@ -0,0 +1,116 @@
+package post
+
+import (
+	"context"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/mute"
+)
+
+const (
+	// MaxConversationDepth caps how many reply levels a conversation loads
+	MaxConversationDepth     = 6
+	maxConversationAncestors = 100
+	maxConversationReplies   = 200
+)
+
+type GetConversationUseCase struct {
+	postRepo   repositories.PostRepository
+	engagement *engagementLoader
+	mutedWords *mute.MutedWordLoader
+}
+
+func NewGetConversationUseCase(postRepo repositories.PostRepository, likeRepo repositories.LikeRepository, bookmarkRepo repositories.BookmarkRepository, mutedWordRepo repositories.MutedWordRepository, followRepo repositories.FollowRepository) *GetConversationUseCase {
+	return &GetConversationUseCase{
+		postRepo:   postRepo,
+		engagement: newEngagementLoader(postRepo, likeRepo, bookmarkRepo),
+		mutedWords: mute.NewMutedWordLoader(mutedWordRepo, followRepo),
+	}
+}
+
+type GetConversationInput struct {
+	PostID uint
+	UserID uint
+	Depth  int
+	Order  repositories.ReplyOrder
+}
+
+func (uc *GetConversationUseCase) Execute(ctx context.Context, input GetConversationInput) (*models.Conversation, error) {
+	ctx = repositories.WithViewer(ctx, input.UserID)
+
+	depth := input.Depth
+	if depth < 1 || depth > MaxConversationDepth {
+		depth = MaxConversationDepth
+	}
+	order := input.Order
+	if order != repositories.ReplyOrderNewest && order != repositories.ReplyOrderOldest {
+		order = repositories.ReplyOrderEngagement
+	}
+
+	// Check the requested post under the viewer before walking the thread,
+	// so a post by a blocked or unfollowed private author is a 404 however
+	// the thread queries below scope their rows
+	if _, err := uc.postRepo.FindByIDIncludingDeleted(ctx, input.PostID); err != nil {
+		return nil, err
+	}
+
+	path, err := uc.postRepo.GetThreadPath(ctx, input.PostID, maxConversationAncestors)
+	if err != nil {
+		return nil, err
+	}
+	// The post may have become hidden between the two reads
+	if len(path) == 0 || path[len(path)-1].ID != input.PostID {
+		return nil, domainErrors.ErrPostNotFound
+	}
+	post := path[len(path)-1]
+
+	replies, err := uc.postRepo.ListReplyTree(ctx, post.ID, post.ConversationID, depth, order, maxConversationReplies)
+	if err != nil {
+		return nil, err
+	}
+
+	// Muted words apply to replies as in GetRepliesUseCase. A hidden reply
+	// takes its own replies with it, since the tree drops orphans.
+	filter, err := uc.mutedWords.Load(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	replies = filter.Apply(replies)
+
+	// Populate the viewer's status across the whole thread at once
+	all := append(append([]*models.Post{}, path...), replies...)
+	if err := uc.engagement.Populate(ctx, input.UserID, all); err != nil {
+		return nil, err
+	}
+
+	return &models.Conversation{
+		Ancestors: path[:len(path)-1],
+		Post:      post,
+		Replies:   buildReplyTree(post.ID, replies),
+	}, nil
+}
+
+// buildReplyTree nests replies under their parents, keeping the order they
+// were ranked in. Replies whose parent was not loaded are dropped.
+func buildReplyTree(rootID uint, replies []*models.Post) []*models.ConversationNode {
+	nodes := make(map[uint]*models.ConversationNode, len(replies)+1)
+	root := &models.ConversationNode{}
+	nodes[rootID] = root
+
+	// Replies come shallowest first, so a parent is always seen before its children
+	for _, reply := range replies {
+		if reply.ParentID == nil {
+			continue
+		}
+		parent, ok := nodes[*reply.ParentID]
+		if !ok {
+			continue
+		}
+		node := &models.ConversationNode{Post: reply}
+		parent.Replies = append(parent.Replies, node)
+		nodes[reply.ID] = node
+	}
+	return root.Replies
+}
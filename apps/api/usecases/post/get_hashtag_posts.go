@@ -0,0 +1,48 @@
+package post
+
+import (
+	"context"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/text"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type GetHashtagPostsUseCase struct {
+	postRepo   repositories.PostRepository
+	engagement *engagementLoader
+}
+
+func NewGetHashtagPostsUseCase(postRepo repositories.PostRepository, likeRepo repositories.LikeRepository, bookmarkRepo repositories.BookmarkRepository) *GetHashtagPostsUseCase {
+	return &GetHashtagPostsUseCase{
+		postRepo:   postRepo,
+		engagement: newEngagementLoader(postRepo, likeRepo, bookmarkRepo),
+	}
+}
+
+type GetHashtagPostsOutput struct {
+	Posts      []*models.Post
+	NextCursor *models.Cursor
+}
+
+func (uc *GetHashtagPostsUseCase) Execute(ctx context.Context, userID uint, tag string, limit int, cursor *models.Cursor) (*GetHashtagPostsOutput, error) {
+	ctx = repositories.WithViewer(ctx, userID)
+
+	tag, ok := text.NormalizeHashtag(tag)
+	if !ok {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	posts, next, err := uc.postRepo.ListByHashtag(ctx, tag, limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Populate IsLiked, IsBookmarked and IsReposted
+	if err := uc.engagement.Populate(ctx, userID, posts); err != nil {
+		return nil, err
+	}
+
+	return &GetHashtagPostsOutput{Posts: posts, NextCursor: next}, nil
+}
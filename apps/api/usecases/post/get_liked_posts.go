@@ -0,0 +1,63 @@
+package post
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type GetLikedPostsUseCase struct {
+	postRepo   repositories.PostRepository
+	userRepo   repositories.UserRepository
+	followRepo repositories.FollowRepository
+	engagement *engagementLoader
+}
+
+func NewGetLikedPostsUseCase(postRepo repositories.PostRepository, userRepo repositories.UserRepository, followRepo repositories.FollowRepository, likeRepo repositories.LikeRepository, bookmarkRepo repositories.BookmarkRepository) *GetLikedPostsUseCase {
+	return &GetLikedPostsUseCase{
+		postRepo:   postRepo,
+		userRepo:   userRepo,
+		followRepo: followRepo,
+		engagement: newEngagementLoader(postRepo, likeRepo, bookmarkRepo),
+	}
+}
+
+type GetLikedPostsOutput struct {
+	Posts      []*models.Post
+	NextCursor *models.Cursor
+}
+
+// Execute lists the posts username liked, newest like first, with the
+// viewer's own engagement status. A private account's likes are only listed
+// for the account itself and its followers; anyone else gets an empty page.
+func (uc *GetLikedPostsUseCase) Execute(ctx context.Context, username string, viewerID uint, limit int, cursor *models.Cursor) (*GetLikedPostsOutput, error) {
+	ctx = repositories.WithViewer(ctx, viewerID)
+
+	user, err := uc.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsPrivate && user.ID != viewerID {
+		follows, err := uc.followRepo.Exists(ctx, viewerID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !follows {
+			return &GetLikedPostsOutput{Posts: []*models.Post{}}, nil
+		}
+	}
+
+	posts, next, err := uc.postRepo.GetLikedPosts(ctx, user.ID, limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Populate IsLiked, IsBookmarked and IsReposted
+	if err := uc.engagement.Populate(ctx, viewerID, posts); err != nil {
+		return nil, err
+	}
+
+	return &GetLikedPostsOutput{Posts: posts, NextCursor: next}, nil
+}
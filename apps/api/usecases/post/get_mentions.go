@@ -0,0 +1,41 @@
+package post
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type GetMentionsUseCase struct {
+	postRepo   repositories.PostRepository
+	engagement *engagementLoader
+}
+
+func NewGetMentionsUseCase(postRepo repositories.PostRepository, likeRepo repositories.LikeRepository, bookmarkRepo repositories.BookmarkRepository) *GetMentionsUseCase {
+	return &GetMentionsUseCase{
+		postRepo:   postRepo,
+		engagement: newEngagementLoader(postRepo, likeRepo, bookmarkRepo),
+	}
+}
+
+type GetMentionsOutput struct {
+	Posts      []*models.Post
+	NextCursor *models.Cursor
+}
+
+func (uc *GetMentionsUseCase) Execute(ctx context.Context, userID uint, limit int, cursor *models.Cursor) (*GetMentionsOutput, error) {
+	ctx = repositories.WithViewer(ctx, userID)
+
+	posts, next, err := uc.postRepo.ListMentioning(ctx, userID, limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Populate IsLiked, IsBookmarked and IsReposted
+	if err := uc.engagement.Populate(ctx, userID, posts); err != nil {
+		return nil, err
+	}
+
+	return &GetMentionsOutput{Posts: posts, NextCursor: next}, nil
+}
@@ -8,23 +8,24 @@ import (
 )
 
 type GetPostDetailUseCase struct {
-	postRepo     repositories.PostRepository
-	userRepo     repositories.UserRepository
-	likeRepo     repositories.LikeRepository
-	bookmarkRepo repositories.BookmarkRepository
+	postRepo   repositories.PostRepository
+	userRepo   repositories.UserRepository
+	engagement *engagementLoader
 }
 
 func NewGetPostDetailUseCase(postRepo repositories.PostRepository, userRepo repositories.UserRepository, likeRepo repositories.LikeRepository, bookmarkRepo repositories.BookmarkRepository) *GetPostDetailUseCase {
 	return &GetPostDetailUseCase{
-		postRepo:     postRepo,
-		userRepo:     userRepo,
-		likeRepo:     likeRepo,
-		bookmarkRepo: bookmarkRepo,
+		postRepo:   postRepo,
+		userRepo:   userRepo,
+		engagement: newEngagementLoader(postRepo, likeRepo, bookmarkRepo),
 	}
 }
 
 func (uc *GetPostDetailUseCase) Execute(ctx context.Context, postID uint, userID uint) (*models.Post, error) {
-	post, err := uc.postRepo.FindByID(ctx, postID)
+	ctx = repositories.WithViewer(ctx, userID)
+
+	// A deleted post still resolves so its thread can show a tombstone
+	post, err := uc.postRepo.FindByIDIncludingDeleted(ctx, postID)
 	if err != nil {
 		return nil, err
 	}
@@ -35,44 +36,9 @@ func (uc *GetPostDetailUseCase) Execute(ctx context.Context, postID uint, userID
 		post.Author = *author
 	}
 
-	// Populate like status and count
-	isLiked, err := uc.likeRepo.Exists(ctx, userID, post.ID)
-	if err == nil {
-		post.IsLiked = isLiked
-	}
-
-	likeCount, err := uc.likeRepo.CountByPostID(ctx, post.ID)
-	if err == nil {
-		post.LikeCount = likeCount
-	}
-
-	// Populate bookmark status and count
-	isBookmarked, err := uc.bookmarkRepo.Exists(ctx, userID, post.ID)
-	if err == nil {
-		post.IsBookmarked = isBookmarked
-	}
-
-	bookmarkCount, err := uc.bookmarkRepo.CountByPostID(ctx, post.ID)
-	if err == nil {
-		post.BookmarkCount = bookmarkCount
-	}
-
-	// Populate reply count
-	replyCount, err := uc.postRepo.CountReplies(ctx, post.ID)
-	if err == nil {
-		post.ReplyCount = replyCount
-	}
-
-	// Populate repost count
-	repostCount, err := uc.postRepo.CountReposts(ctx, post.ID)
-	if err == nil {
-		post.RepostCount = repostCount
-	}
-
-	// Check if reposted
-	isReposted, err := uc.postRepo.CheckReposted(ctx, userID, post.ID)
-	if err == nil {
-		post.IsReposted = isReposted
+	// Populate like, bookmark and repost status
+	if err := uc.engagement.Populate(ctx, userID, []*models.Post{post}); err != nil {
+		return nil, err
 	}
 
 	return post, nil
@@ -0,0 +1,31 @@
+package post
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type GetPostRevisionsUseCase struct {
+	postRepo     repositories.PostRepository
+	revisionRepo repositories.PostRevisionRepository
+}
+
+func NewGetPostRevisionsUseCase(postRepo repositories.PostRepository, revisionRepo repositories.PostRevisionRepository) *GetPostRevisionsUseCase {
+	return &GetPostRevisionsUseCase{
+		postRepo:     postRepo,
+		revisionRepo: revisionRepo,
+	}
+}
+
+// Execute returns the earlier versions of a post, newest first. The current
+// content is not included.
+func (uc *GetPostRevisionsUseCase) Execute(ctx context.Context, postID, userID uint) ([]*models.PostRevision, error) {
+	ctx = repositories.WithViewer(ctx, userID)
+
+	if _, err := uc.postRepo.FindByID(ctx, postID); err != nil {
+		return nil, err
+	}
+	return uc.revisionRepo.ListByPostID(ctx, postID)
+}
@@ -0,0 +1,45 @@
+package post
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type GetQuotesUseCase struct {
+	postRepo   repositories.PostRepository
+	engagement *engagementLoader
+}
+
+func NewGetQuotesUseCase(postRepo repositories.PostRepository, likeRepo repositories.LikeRepository, bookmarkRepo repositories.BookmarkRepository) *GetQuotesUseCase {
+	return &GetQuotesUseCase{
+		postRepo:   postRepo,
+		engagement: newEngagementLoader(postRepo, likeRepo, bookmarkRepo),
+	}
+}
+
+type GetQuotesOutput struct {
+	Posts      []*models.Post
+	NextCursor *models.Cursor
+}
+
+func (uc *GetQuotesUseCase) Execute(ctx context.Context, postID uint, userID uint, limit int, cursor *models.Cursor) (*GetQuotesOutput, error) {
+	ctx = repositories.WithViewer(ctx, userID)
+
+	if _, err := uc.postRepo.FindByIDIncludingDeleted(ctx, postID); err != nil {
+		return nil, err
+	}
+
+	posts, next, err := uc.postRepo.ListQuotes(ctx, postID, limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Populate IsLiked, IsBookmarked and IsReposted
+	if err := uc.engagement.Populate(ctx, userID, posts); err != nil {
+		return nil, err
+	}
+
+	return &GetQuotesOutput{Posts: posts, NextCursor: next}, nil
+}
@@ -5,78 +5,53 @@ import (
 
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/mute"
 )
 
 type GetRepliesUseCase struct {
-	postRepo     repositories.PostRepository
-	userRepo     repositories.UserRepository
-	likeRepo     repositories.LikeRepository
-	bookmarkRepo repositories.BookmarkRepository
+	postRepo   repositories.PostRepository
+	engagement *engagementLoader
+	mutedWords *mute.MutedWordLoader
 }
 
-func NewGetRepliesUseCase(postRepo repositories.PostRepository, userRepo repositories.UserRepository, likeRepo repositories.LikeRepository, bookmarkRepo repositories.BookmarkRepository) *GetRepliesUseCase {
+func NewGetRepliesUseCase(postRepo repositories.PostRepository, likeRepo repositories.LikeRepository, bookmarkRepo repositories.BookmarkRepository, followRepo repositories.FollowRepository, mutedWordRepo repositories.MutedWordRepository) *GetRepliesUseCase {
 	return &GetRepliesUseCase{
-		postRepo:     postRepo,
-		userRepo:     userRepo,
-		likeRepo:     likeRepo,
-		bookmarkRepo: bookmarkRepo,
+		postRepo:   postRepo,
+		engagement: newEngagementLoader(postRepo, likeRepo, bookmarkRepo),
+		mutedWords: mute.NewMutedWordLoader(mutedWordRepo, followRepo),
 	}
 }
 
-func (uc *GetRepliesUseCase) Execute(ctx context.Context, postID uint, userID uint) ([]*models.Post, error) {
-	replies, err := uc.postRepo.GetReplies(ctx, postID)
-	if err != nil {
-		return nil, err
-	}
-
-	// Populate author and counts for each reply
-	for _, reply := range replies {
-		// Fetch author
-		author, err := uc.userRepo.FindByID(ctx, reply.AuthorID)
-		if err == nil {
-			reply.Author = *author
-		}
-
-		// Populate like status and count
-		isLiked, err := uc.likeRepo.Exists(ctx, userID, reply.ID)
-		if err == nil {
-			reply.IsLiked = isLiked
-		}
-
-		likeCount, err := uc.likeRepo.CountByPostID(ctx, reply.ID)
-		if err == nil {
-			reply.LikeCount = likeCount
-		}
+type GetRepliesOutput struct {
+	Posts      []*models.Post
+	NextCursor *models.Cursor
+}
 
-		// Populate bookmark status and count
-		isBookmarked, err := uc.bookmarkRepo.Exists(ctx, userID, reply.ID)
-		if err == nil {
-			reply.IsBookmarked = isBookmarked
-		}
+func (uc *GetRepliesUseCase) Execute(ctx context.Context, postID uint, userID uint, limit int, cursor *models.Cursor) (*GetRepliesOutput, error) {
+	ctx = repositories.WithViewer(ctx, userID)
 
-		bookmarkCount, err := uc.bookmarkRepo.CountByPostID(ctx, reply.ID)
-		if err == nil {
-			reply.BookmarkCount = bookmarkCount
-		}
+	// Replies to a post the viewer cannot see are hidden along with it. A
+	// deleted parent still shows its replies, as in a conversation.
+	if _, err := uc.postRepo.FindByIDIncludingDeleted(ctx, postID); err != nil {
+		return nil, err
+	}
 
-		// Populate reply count
-		replyCount, err := uc.postRepo.CountReplies(ctx, reply.ID)
-		if err == nil {
-			reply.ReplyCount = replyCount
-		}
+	// Authors are preloaded by the repository
+	replies, next, err := uc.postRepo.GetReplies(ctx, postID, limit, cursor)
+	if err != nil {
+		return nil, err
+	}
 
-		// Populate repost count
-		repostCount, err := uc.postRepo.CountReposts(ctx, reply.ID)
-		if err == nil {
-			reply.RepostCount = repostCount
-		}
+	filter, err := uc.mutedWords.Load(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	replies = filter.Apply(replies)
 
-		// Check if reposted
-		isReposted, err := uc.postRepo.CheckReposted(ctx, userID, reply.ID)
-		if err == nil {
-			reply.IsReposted = isReposted
-		}
+	// Populate the viewer's status for each reply
+	if err := uc.engagement.Populate(ctx, userID, replies); err != nil {
+		return nil, err
 	}
 
-	return replies, nil
+	return &GetRepliesOutput{Posts: replies, NextCursor: next}, nil
 }
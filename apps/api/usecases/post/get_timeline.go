@@ -2,159 +2,200 @@ package post
 
 import (
 	"context"
+	"log"
+	"sort"
 
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	infraTimeline "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/timeline"
 	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/usecases/mute"
 )
 
 type GetTimelineUseCase struct {
-	postRepo repositories.PostRepository
-	likeRepo repositories.LikeRepository
-	bookmarkRepo repositories.BookmarkRepository
+	postRepo           repositories.PostRepository
+	followRepo         repositories.FollowRepository
+	timelineCache      *infraTimeline.TimelineCache
+	celebrityThreshold int64
+	engagement         *engagementLoader
+	mutedWords         *mute.MutedWordLoader
 }
 
-func NewGetTimelineUseCase(postRepo repositories.PostRepository, likeRepo repositories.LikeRepository, bookmarkRepo repositories.BookmarkRepository) *GetTimelineUseCase {
+func NewGetTimelineUseCase(postRepo repositories.PostRepository, likeRepo repositories.LikeRepository, bookmarkRepo repositories.BookmarkRepository, followRepo repositories.FollowRepository, mutedWordRepo repositories.MutedWordRepository, timelineCache *infraTimeline.TimelineCache, celebrityThreshold int64) *GetTimelineUseCase {
 	return &GetTimelineUseCase{
-		postRepo: postRepo,
-		likeRepo: likeRepo,
-		bookmarkRepo: bookmarkRepo,
+		postRepo:           postRepo,
+		followRepo:         followRepo,
+		timelineCache:      timelineCache,
+		celebrityThreshold: celebrityThreshold,
+		engagement:         newEngagementLoader(postRepo, likeRepo, bookmarkRepo),
+		mutedWords:         mute.NewMutedWordLoader(mutedWordRepo, followRepo),
 	}
 }
 
 type GetTimelineInput struct {
 	Limit        int
-	Offset       int
+	Cursor       *models.Cursor
 	UserID       uint
 	TargetUserID *uint
 }
 
 type GetTimelineOutput struct {
-	Posts     []*models.Post
-	LikeMap   map[uint]bool
-	LikeCounts map[uint]int64
-	BookmarkMap map[uint]bool
-	BookmarkCounts map[uint]int64
+	Posts      []*models.Post
+	NextCursor *models.Cursor
 }
 
 func (uc *GetTimelineUseCase) Execute(ctx context.Context, input GetTimelineInput) (*GetTimelineOutput, error) {
-	posts, err := uc.postRepo.List(ctx, input.Limit, input.Offset, input.TargetUserID)
+	ctx = repositories.WithViewer(ctx, input.UserID)
+
+	var posts []*models.Post
+	var next *models.Cursor
+	var err error
+	if input.TargetUserID != nil {
+		posts, next, err = uc.postRepo.List(ctx, input.TargetUserID, input.Limit, input.Cursor)
+	} else {
+		// Home timeline: only the caller and the accounts they follow
+		posts, next, err = uc.homeTimeline(ctx, input.UserID, input.Limit, input.Cursor)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	likeMap := make(map[uint]bool)
-	likeCounts := make(map[uint]int64)
-	bookmarkMap := make(map[uint]bool)
-	bookmarkCounts := make(map[uint]int64)
+	// Muted words thin out the page; the cursor still follows the unfiltered one
+	filter, err := uc.mutedWords.Load(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	posts = filter.Apply(posts)
 
-	for _, post := range posts {
-		// Check if liked by user
-		exists, err := uc.likeRepo.Exists(ctx, input.UserID, post.ID)
-		if err != nil {
-			return nil, err
-		}
-		likeMap[post.ID] = exists
+	// Populate IsLiked, IsBookmarked and IsReposted
+	if err := uc.engagement.Populate(ctx, input.UserID, posts); err != nil {
+		return nil, err
+	}
 
-		// Get like count
-		count, err := uc.likeRepo.CountByPostID(ctx, post.ID)
-		if err != nil {
-			return nil, err
-		}
-		likeCounts[post.ID] = count
-		post.LikeCount = count
+	return &GetTimelineOutput{Posts: posts, NextCursor: next}, nil
+}
 
-		// Check if bookmarked by user
-		exists, err = uc.bookmarkRepo.Exists(ctx, input.UserID, post.ID)
+// maxCacheReads bounds how many times one home timeline page reads further
+// into the cache to replace entries that no longer hydrate.
+const maxCacheReads = 3
+
+// homeTimeline reads the user's home timeline from the Redis cache, warming
+// it from Postgres on a miss, and merges in posts from followed celebrity
+// accounts that are not fanned out on write. Pages the cache cannot serve,
+// and any read where Redis fails, come straight from Postgres.
+func (uc *GetTimelineUseCase) homeTimeline(ctx context.Context, userID uint, limit int, cursor *models.Cursor) ([]*models.Post, *models.Cursor, error) {
+	posts, hasMore, ok, err := uc.readCache(ctx, userID, limit, cursor)
+	if err != nil {
+		log.Printf("failed to read cached timeline for user %d: %v", userID, err)
+		return uc.postRepo.ListHomeTimeline(ctx, userID, limit, cursor)
+	}
+
+	if !ok && cursor == nil {
+		entries, err := uc.postRepo.ListHomeTimelineEntries(ctx, userID, int(uc.timelineCache.Capacity()))
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		bookmarkMap[post.ID] = exists
-		post.IsBookmarked = exists
-
-		// Get bookmark count
-		count, err = uc.bookmarkRepo.CountByPostID(ctx, post.ID)
+		if err := uc.timelineCache.Fill(ctx, userID, entries); err != nil {
+			log.Printf("failed to warm cached timeline for user %d: %v", userID, err)
+			return uc.postRepo.ListHomeTimeline(ctx, userID, limit, cursor)
+		}
+		posts, hasMore, ok, err = uc.readCache(ctx, userID, limit, cursor)
 		if err != nil {
-			return nil, err
+			log.Printf("failed to read cached timeline for user %d: %v", userID, err)
+			return uc.postRepo.ListHomeTimeline(ctx, userID, limit, cursor)
 		}
-		bookmarkCounts[post.ID] = count
-		post.BookmarkCount = count
+	}
+
+	if !ok {
+		return uc.postRepo.ListHomeTimeline(ctx, userID, limit, cursor)
+	}
 
-		// Get reply count
-		replyCount, err := uc.postRepo.CountReplies(ctx, post.ID)
+	// Fan-out-on-read for accounts with huge follower counts
+	celebrityIDs, err := uc.followRepo.ListFolloweeIDsWithMinFollowers(ctx, userID, uc.celebrityThreshold)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(celebrityIDs) > 0 {
+		celebrityPosts, celebrityNext, err := uc.postRepo.ListByAuthors(ctx, celebrityIDs, limit, cursor)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		post.ReplyCount = replyCount
+		posts = mergePosts(posts, celebrityPosts)
+		hasMore = hasMore || celebrityNext != nil
+	}
+
+	if len(posts) > limit {
+		posts = posts[:limit]
+		hasMore = true
+	}
+	var next *models.Cursor
+	if hasMore && len(posts) > 0 {
+		last := posts[len(posts)-1]
+		next = &models.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+	return posts, next, nil
+}
 
-		// Get repost count
-		repostCount, err := uc.postRepo.CountReposts(ctx, post.ID)
+// readCache hydrates up to limit+1 cached posts older than cursor. Cached
+// entries that no longer hydrate, because the post was deleted or its
+// author has since been blocked or muted, are replaced by reading further
+// into the cache so the page is not cut short. It reports whether more
+// posts follow and whether the cache could serve the page at all.
+func (uc *GetTimelineUseCase) readCache(ctx context.Context, userID uint, limit int, cursor *models.Cursor) ([]*models.Post, bool, bool, error) {
+	var posts []*models.Post
+	for read := 0; read < maxCacheReads; read++ {
+		want := limit + 1 - len(posts)
+		entries, ok, exhausted, err := uc.timelineCache.Range(ctx, userID, cursor, want)
 		if err != nil {
-			return nil, err
+			return nil, false, false, err
+		}
+		if !ok || exhausted {
+			return nil, false, false, nil
 		}
-		post.RepostCount = repostCount
 
-		// Check if user has reposted this post
-		isReposted, err := uc.postRepo.CheckReposted(ctx, input.UserID, post.ID)
+		ids := make([]uint, 0, len(entries))
+		for _, entry := range entries {
+			ids = append(ids, entry.PostID)
+		}
+		hydrated, err := uc.postRepo.FindByIDs(ctx, ids)
 		if err != nil {
-			return nil, err
+			return nil, false, false, err
 		}
-		post.IsReposted = isReposted
-		
-		// Handle Repost
-		if post.Repost != nil {
-			exists, err := uc.likeRepo.Exists(ctx, input.UserID, post.Repost.ID)
-			if err != nil {
-				return nil, err
-			}
-			likeMap[post.Repost.ID] = exists
-			post.Repost.IsLiked = exists
-
-			count, err := uc.likeRepo.CountByPostID(ctx, post.Repost.ID)
-			if err != nil {
-				return nil, err
-			}
-			likeCounts[post.Repost.ID] = count
-			post.Repost.LikeCount = count
-
-			exists, err = uc.bookmarkRepo.Exists(ctx, input.UserID, post.Repost.ID)
-			if err != nil {
-				return nil, err
-			}
-			bookmarkMap[post.Repost.ID] = exists
-			post.Repost.IsBookmarked = exists
-
-			count, err = uc.bookmarkRepo.CountByPostID(ctx, post.Repost.ID)
-			if err != nil {
-				return nil, err
-			}
-			bookmarkCounts[post.Repost.ID] = count
-			post.Repost.BookmarkCount = count
+		posts = append(posts, hydrated...)
 
-			replyCount, err := uc.postRepo.CountReplies(ctx, post.Repost.ID)
-			if err != nil {
-				return nil, err
-			}
-			post.Repost.ReplyCount = replyCount
-
-			repostCount, err := uc.postRepo.CountReposts(ctx, post.Repost.ID)
-			if err != nil {
-				return nil, err
-			}
-			post.Repost.RepostCount = repostCount
+		if len(posts) > limit {
+			return posts, true, true, nil
+		}
+		if len(entries) < want {
+			// Reached the end of the timeline
+			return posts, false, true, nil
+		}
+		last := entries[len(entries)-1]
+		cursor = &models.Cursor{CreatedAt: last.CreatedAt, ID: last.PostID}
+	}
+	// Gave up topping up the page; the next one picks up from here. If
+	// nothing hydrated at all, let Postgres serve it instead.
+	return posts, true, len(posts) > 0, nil
+}
 
-			isReposted, err := uc.postRepo.CheckReposted(ctx, input.UserID, post.Repost.ID)
-			if err != nil {
-				return nil, err
+// mergePosts combines two post lists newest first, dropping duplicates.
+func mergePosts(a, b []*models.Post) []*models.Post {
+	seen := make(map[uint]bool, len(a)+len(b))
+	merged := make([]*models.Post, 0, len(a)+len(b))
+	for _, list := range [][]*models.Post{a, b} {
+		for _, p := range list {
+			if seen[p.ID] {
+				continue
 			}
-			post.Repost.IsReposted = isReposted
+			seen[p.ID] = true
+			merged = append(merged, p)
 		}
 	}
 
-	return &GetTimelineOutput{
-		Posts:      posts,
-		LikeMap:    likeMap,
-		LikeCounts: likeCounts,
-		BookmarkMap: bookmarkMap,
-		BookmarkCounts: bookmarkCounts,
-	}, nil
+	sort.Slice(merged, func(i, j int) bool {
+		if merged[i].CreatedAt.Equal(merged[j].CreatedAt) {
+			return merged[i].ID > merged[j].ID
+		}
+		return merged[i].CreatedAt.After(merged[j].CreatedAt)
+	})
+	return merged
 }
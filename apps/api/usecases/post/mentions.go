@@ -0,0 +1,41 @@
+package post
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/text"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+// resolveMentions parses the @username tokens in content and keeps those that
+// name an existing user. Tokens for unknown usernames stay plain text.
+func resolveMentions(ctx context.Context, userRepo repositories.UserRepository, content string) ([]models.PostMention, error) {
+	tokens := text.ExtractMentions(content)
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	users, err := userRepo.FindByUsernames(ctx, text.UniqueUsernames(tokens))
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make(map[string]uint, len(users))
+	for _, user := range users {
+		userIDs[user.Username] = user.ID
+	}
+
+	var mentions []models.PostMention
+	for _, token := range tokens {
+		userID, ok := userIDs[token.Username]
+		if !ok {
+			continue
+		}
+		mentions = append(mentions, models.PostMention{
+			UserID: userID,
+			Start:  token.Start,
+			End:    token.End,
+		})
+	}
+	return mentions, nil
+}
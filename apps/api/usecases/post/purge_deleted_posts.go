@@ -0,0 +1,92 @@
+package post
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	infraStorage "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/storage"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+const purgeBatchSize = 500
+
+// PurgeDeletedPostsUseCase removes the content of posts deleted longer ago
+// than the retention period. Tombstones that replies or reposts still point
+// at are kept, emptied, so threads stay intact; the rest are removed.
+type PurgeDeletedPostsUseCase struct {
+	postRepo     repositories.PostRepository
+	mediaRepo    repositories.MediaRepository
+	txManager    repositories.TransactionManager
+	mediaStorage infraStorage.MediaStorage
+}
+
+func NewPurgeDeletedPostsUseCase(postRepo repositories.PostRepository, mediaRepo repositories.MediaRepository, txManager repositories.TransactionManager, mediaStorage infraStorage.MediaStorage) *PurgeDeletedPostsUseCase {
+	return &PurgeDeletedPostsUseCase{
+		postRepo:     postRepo,
+		mediaRepo:    mediaRepo,
+		txManager:    txManager,
+		mediaStorage: mediaStorage,
+	}
+}
+
+type PurgeDeletedPostsOutput struct {
+	Purged  int64
+	Removed int64
+}
+
+func (uc *PurgeDeletedPostsUseCase) Execute(ctx context.Context, retention time.Duration) (*PurgeDeletedPostsOutput, error) {
+	now := time.Now()
+	cutoff := now.Add(-retention)
+	output := &PurgeDeletedPostsOutput{}
+
+	for {
+		postIDs, err := uc.postRepo.ListPurgeable(ctx, cutoff, purgeBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(postIDs) == 0 {
+			return output, nil
+		}
+
+		var media []*models.Media
+		var removed int64
+		err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+			media, err = uc.mediaRepo.ListByPostIDs(ctx, postIDs)
+			if err != nil {
+				return err
+			}
+			if err := uc.mediaRepo.DeleteByPostIDs(ctx, postIDs); err != nil {
+				return err
+			}
+			if err := uc.postRepo.PurgeContent(ctx, postIDs, now); err != nil {
+				return err
+			}
+			removed, err = uc.postRepo.DeleteUnreferenced(ctx, postIDs)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		output.Purged += int64(len(postIDs))
+		output.Removed += removed
+
+		// The rows are gone either way; a leftover file is only wasted space
+		for _, m := range media {
+			keys := []string{m.StorageKey}
+			for _, v := range m.Variants {
+				keys = append(keys, v.StorageKey)
+			}
+			for _, key := range keys {
+				if err := uc.mediaStorage.Delete(ctx, key); err != nil {
+					log.Printf("failed to delete media %s: %v", key, err)
+				}
+			}
+		}
+
+		if len(postIDs) < purgeBatchSize {
+			return output, nil
+		}
+	}
+}
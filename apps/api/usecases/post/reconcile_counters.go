@@ -0,0 +1,43 @@
+package post
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+// ReconcileCountersUseCase recomputes the denormalized engagement counters
+// on posts and the follow counters on users, fixing any drift.
+type ReconcileCountersUseCase struct {
+	postRepo repositories.PostRepository
+	userRepo repositories.UserRepository
+}
+
+func NewReconcileCountersUseCase(postRepo repositories.PostRepository, userRepo repositories.UserRepository) *ReconcileCountersUseCase {
+	return &ReconcileCountersUseCase{
+		postRepo: postRepo,
+		userRepo: userRepo,
+	}
+}
+
+type ReconcileCountersOutput struct {
+	PostsFixed int64
+	UsersFixed int64
+}
+
+func (uc *ReconcileCountersUseCase) Execute(ctx context.Context) (*ReconcileCountersOutput, error) {
+	postsFixed, err := uc.postRepo.ReconcileCounters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usersFixed, err := uc.userRepo.ReconcileFollowCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReconcileCountersOutput{
+		PostsFixed: postsFixed,
+		UsersFixed: usersFixed,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package post
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type SearchPostsUseCase struct {
+	postRepo   repositories.PostRepository
+	engagement *engagementLoader
+}
+
+func NewSearchPostsUseCase(postRepo repositories.PostRepository, likeRepo repositories.LikeRepository, bookmarkRepo repositories.BookmarkRepository) *SearchPostsUseCase {
+	return &SearchPostsUseCase{
+		postRepo:   postRepo,
+		engagement: newEngagementLoader(postRepo, likeRepo, bookmarkRepo),
+	}
+}
+
+type SearchPostsInput struct {
+	Query  string
+	UserID uint
+	Limit  int
+	Cursor *models.Cursor
+}
+
+type SearchPostsOutput struct {
+	Posts      []*models.Post
+	NextCursor *models.Cursor
+}
+
+func (uc *SearchPostsUseCase) Execute(ctx context.Context, input SearchPostsInput) (*SearchPostsOutput, error) {
+	ctx = repositories.WithViewer(ctx, input.UserID)
+
+	query, err := models.ParsePostSearchQuery(input.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, next, err := uc.postRepo.Search(ctx, query, input.Limit, input.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Populate IsLiked, IsBookmarked and IsReposted
+	if err := uc.engagement.Populate(ctx, input.UserID, posts); err != nil {
+		return nil, err
+	}
+
+	return &SearchPostsOutput{Posts: posts, NextCursor: next}, nil
+}
@@ -0,0 +1,43 @@
+package post
+
+import (
+	"context"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type UndoRepostUseCase struct {
+	postRepo  repositories.PostRepository
+	txManager repositories.TransactionManager
+}
+
+func NewUndoRepostUseCase(postRepo repositories.PostRepository, txManager repositories.TransactionManager) *UndoRepostUseCase {
+	return &UndoRepostUseCase{
+		postRepo:  postRepo,
+		txManager: txManager,
+	}
+}
+
+// Execute removes userID's plain repost of postID. Quotes of postID are left
+// alone. It returns ErrPostNotFound if the user has not reposted it.
+//
+// The repost is not evicted from followers' cached home timelines; the
+// timeline read skips entries that no longer hydrate and tops the page up
+// from further down the cache.
+func (uc *UndoRepostUseCase) Execute(ctx context.Context, postID uint, userID uint) error {
+	return uc.txManager.Do(ctx, func(ctx context.Context) error {
+		repost, err := uc.postRepo.FindRepost(ctx, userID, postID)
+		if err != nil {
+			return err
+		}
+		deleted, err := uc.postRepo.Delete(ctx, repost.ID)
+		if err != nil {
+			return err
+		}
+		// A concurrent un-repost already removed it and adjusted the count
+		if !deleted {
+			return nil
+		}
+		return uc.postRepo.IncrementCounter(ctx, postID, repositories.PostCounterReposts, -1)
+	})
+}
@@ -0,0 +1,41 @@
+package stream
+
+import (
+	"context"
+
+	infraStream "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/stream"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type SubscribeEventsUseCase struct {
+	followRepo  repositories.FollowRepository
+	eventBroker *infraStream.EventBroker
+}
+
+func NewSubscribeEventsUseCase(followRepo repositories.FollowRepository, eventBroker *infraStream.EventBroker) *SubscribeEventsUseCase {
+	return &SubscribeEventsUseCase{
+		followRepo:  followRepo,
+		eventBroker: eventBroker,
+	}
+}
+
+// Execute subscribes the user to events about posts by themselves and by
+// the accounts they follow. The set of followed accounts is read once, when
+// the subscription starts.
+func (uc *SubscribeEventsUseCase) Execute(ctx context.Context, userID uint) (<-chan infraStream.Event, func(), error) {
+	followeeIDs, err := uc.followRepo.ListFolloweeIDs(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	relevant := make(map[uint]bool, len(followeeIDs)+1)
+	relevant[userID] = true
+	for _, id := range followeeIDs {
+		relevant[id] = true
+	}
+
+	events, unsubscribe := uc.eventBroker.Subscribe(func(event infraStream.Event) bool {
+		return relevant[event.AuthorID]
+	})
+	return events, unsubscribe, nil
+}
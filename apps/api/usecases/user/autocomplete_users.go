@@ -0,0 +1,32 @@
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+// autocompleteLimit keeps the @mention picker short
+const autocompleteLimit = 8
+
+type AutocompleteUsersUseCase struct {
+	userRepo repositories.UserRepository
+}
+
+func NewAutocompleteUsersUseCase(userRepo repositories.UserRepository) *AutocompleteUsersUseCase {
+	return &AutocompleteUsersUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute suggests usernames starting with prefix. An empty prefix yields no
+// suggestions rather than an arbitrary slice of all users.
+func (uc *AutocompleteUsersUseCase) Execute(ctx context.Context, viewerID uint, prefix string) ([]*models.User, error) {
+	prefix = strings.TrimPrefix(strings.TrimSpace(prefix), "@")
+	if prefix == "" {
+		return []*models.User{}, nil
+	}
+	return uc.userRepo.AutocompleteUsernames(ctx, viewerID, prefix, autocompleteLimit)
+}
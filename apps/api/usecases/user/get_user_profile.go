@@ -8,13 +8,67 @@ import (
 )
 
 type GetUserProfileUseCase struct {
-	userRepo repositories.UserRepository
+	userRepo    repositories.UserRepository
+	followRepo  repositories.FollowRepository
+	requestRepo repositories.FollowRequestRepository
+	postRepo    repositories.PostRepository
 }
 
-func NewGetUserProfileUseCase(userRepo repositories.UserRepository) *GetUserProfileUseCase {
-	return &GetUserProfileUseCase{userRepo: userRepo}
+func NewGetUserProfileUseCase(userRepo repositories.UserRepository, followRepo repositories.FollowRepository, requestRepo repositories.FollowRequestRepository, postRepo repositories.PostRepository) *GetUserProfileUseCase {
+	return &GetUserProfileUseCase{
+		userRepo:    userRepo,
+		followRepo:  followRepo,
+		requestRepo: requestRepo,
+		postRepo:    postRepo,
+	}
 }
 
-func (uc *GetUserProfileUseCase) Execute(ctx context.Context, username string) (*models.User, error) {
-	return uc.userRepo.FindByUsername(ctx, username)
+type GetUserProfileOutput struct {
+	User           *models.User
+	FollowerCount  int64
+	FollowingCount int64
+	PostCount      int64
+	IsFollowedByMe bool
+	FollowsMe      bool
+	IsRequested    bool
+	ShowBirthday   bool
+}
+
+func (uc *GetUserProfileUseCase) Execute(ctx context.Context, username string, viewerID uint) (*GetUserProfileOutput, error) {
+	user, err := uc.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	postCount, err := uc.postRepo.CountByAuthorID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Relationship between the viewer and this user
+	isFollowedByMe, err := uc.followRepo.Exists(ctx, viewerID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	followsMe, err := uc.followRepo.Exists(ctx, user.ID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	isRequested, err := uc.requestRepo.Exists(ctx, viewerID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetUserProfileOutput{
+		User:           user,
+		FollowerCount:  user.FollowerCount,
+		FollowingCount: user.FollowingCount,
+		PostCount:      postCount,
+		IsFollowedByMe: isFollowedByMe,
+		FollowsMe:      followsMe,
+		IsRequested:    isRequested,
+		ShowBirthday:   user.BirthdayVisibleTo(viewerID, isFollowedByMe),
+	}, nil
 }
@@ -0,0 +1,41 @@
+package user
+
+import (
+	"context"
+	"strings"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type SearchUsersUseCase struct {
+	userRepo repositories.UserRepository
+}
+
+func NewSearchUsersUseCase(userRepo repositories.UserRepository) *SearchUsersUseCase {
+	return &SearchUsersUseCase{
+		userRepo: userRepo,
+	}
+}
+
+type SearchUsersInput struct {
+	Query  string
+	Order  repositories.UserSearchOrder
+	Limit  int
+	Offset int
+}
+
+func (uc *SearchUsersUseCase) Execute(ctx context.Context, input SearchUsersInput) ([]*models.User, error) {
+	q := strings.TrimPrefix(strings.TrimSpace(input.Query), "@")
+	if q == "" {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	order := input.Order
+	if order != repositories.UserSearchOrderFollowers {
+		order = repositories.UserSearchOrderRelevance
+	}
+
+	return uc.userRepo.Search(ctx, q, order, input.Limit, input.Offset)
+}
@@ -0,0 +1,36 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+type UpdateProfileUseCase struct {
+	userRepo repositories.UserRepository
+}
+
+func NewUpdateProfileUseCase(userRepo repositories.UserRepository) *UpdateProfileUseCase {
+	return &UpdateProfileUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute applies a partial profile edit. Invalid fields are reported as
+// ErrInvalidInput and nothing is saved.
+func (uc *UpdateProfileUseCase) Execute(ctx context.Context, userID uint, update models.ProfileUpdate) (*models.User, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := update.ApplyTo(user, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.userRepo.UpdateProfile(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
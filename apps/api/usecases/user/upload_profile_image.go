@@ -0,0 +1,115 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/google/uuid"
+
+	domainErrors "github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/errors"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/domains/models"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/imaging"
+	infraStorage "github.com/taiji-shibata/antigravity-x-clone/apps/api/infrastructures/storage"
+	"github.com/taiji-shibata/antigravity-x-clone/apps/api/repositories"
+)
+
+// profileImageWidths caps the stored width of each profile image; smaller
+// uploads are kept at their own size
+var profileImageWidths = map[models.ProfileImage]int{
+	models.ProfileImageAvatar: 400,
+	models.ProfileImageBanner: 1500,
+}
+
+var profileImageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+var profileImageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
+
+type UploadProfileImageUseCase struct {
+	userRepo repositories.UserRepository
+	storage  infraStorage.MediaStorage
+}
+
+func NewUploadProfileImageUseCase(userRepo repositories.UserRepository, storage infraStorage.MediaStorage) *UploadProfileImageUseCase {
+	return &UploadProfileImageUseCase{
+		userRepo: userRepo,
+		storage:  storage,
+	}
+}
+
+// Execute validates an uploaded image by its content, strips its metadata,
+// scales it down and makes it the user's avatar or banner. The image it
+// replaces is removed from storage.
+func (uc *UploadProfileImageUseCase) Execute(ctx context.Context, userID uint, kind models.ProfileImage, data []byte) (*models.User, error) {
+	maxWidth, ok := profileImageWidths[kind]
+	if !ok {
+		return nil, domainErrors.ErrInvalidInput
+	}
+	if len(data) > models.MaxMediaSize {
+		return nil, domainErrors.ErrMediaTooLarge
+	}
+
+	mimeType := mimetype.Detect(data).String()
+	if !profileImageTypes[mimeType] {
+		return nil, domainErrors.ErrUnsupportedMedia
+	}
+
+	sanitized, err := imaging.Sanitize(data, mimeType)
+	if err != nil {
+		if errors.Is(err, imaging.ErrUnsupportedFormat) || errors.Is(err, imaging.ErrTooManyPixels) {
+			return nil, fmt.Errorf("%w: %v", domainErrors.ErrUnsupportedMedia, err)
+		}
+		return nil, err
+	}
+
+	// Profile images are re-encoded as stills, so animated GIFs keep their
+	// first frame
+	img, err := imaging.Decode(sanitized.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domainErrors.ErrUnsupportedMedia, err)
+	}
+	if img.Bounds().Dx() > maxWidth {
+		img = imaging.Resize(img, maxWidth)
+	}
+	encoded, encodedType, err := imaging.EncodeVariant(img)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.userRepo.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%ss/%d/%s%s", kind, userID, uuid.NewString(), profileImageExtensions[encodedType])
+	if err := uc.storage.Put(ctx, key, encoded, encodedType); err != nil {
+		return nil, err
+	}
+
+	// Only the image columns are written, and the key being replaced is read
+	// in the same statement, so a concurrent profile edit or upload can't
+	// restore a key whose file is deleted below
+	oldKey, err := uc.userRepo.SetProfileImage(ctx, userID, kind, key, uc.storage.URL(key))
+	if err != nil {
+		// Don't leave an unreferenced file behind
+		if delErr := uc.storage.Delete(ctx, key); delErr != nil {
+			log.Printf("failed to delete orphaned %s %s: %v", kind, key, delErr)
+		}
+		return nil, err
+	}
+
+	if oldKey != "" {
+		if err := uc.storage.Delete(ctx, oldKey); err != nil {
+			log.Printf("failed to delete replaced %s %s: %v", kind, oldKey, err)
+		}
+	}
+	return uc.userRepo.FindByID(ctx, userID)
+}
@@ -3,7 +3,7 @@
 import { useParams, useRouter } from 'next/navigation';
 import { useState, useEffect } from 'react';
 import Link from 'next/link';
-import { Post } from '@/features/timeline/types/post';
+import { Post, PostPage } from '@/features/timeline/types/post';
 import { toggleLike as apiToggleLike, toggleBookmark as apiToggleBookmark, createPost } from '@/features/timeline/api/timelineApi';
 
 const API_URL = 'http://localhost:8080/api';
@@ -40,8 +40,8 @@ export default function PostDetailPage() {
             if (!repliesResponse.ok) {
                 throw new Error('Failed to fetch replies');
             }
-            const repliesData = await repliesResponse.json();
-            setReplies(repliesData);
+            const repliesData: PostPage = await repliesResponse.json();
+            setReplies(repliesData.posts);
         } catch (err: any) {
             setError(err.message);
         } finally {
@@ -118,6 +118,13 @@ export default function PostDetailPage() {
             </div>
 
             {/* Main Post */}
+            {post.is_deleted ? (
+                <div className="border-b border-[var(--border-color)] p-4">
+                    <div className="rounded-xl bg-[var(--hover-bg)] px-4 py-3 text-gray-500">
+                        This post was deleted
+                    </div>
+                </div>
+            ) : (
             <div className="border-b border-[var(--border-color)] p-4">
                 <div className="flex space-x-3">
                     <div className="flex-shrink-0">
@@ -191,6 +198,7 @@ export default function PostDetailPage() {
                     </div>
                 </div>
             </div>
+            )}
 
             {/* Reply Form */}
             <div className="border-b border-[var(--border-color)] p-4">
@@ -221,7 +229,14 @@ export default function PostDetailPage() {
 
             {/* Replies */}
             <div>
-                {replies && replies.map((reply) => (
+                {replies && replies.map((reply) => reply.is_deleted ? (
+                    <div key={reply.id} className="border-b border-[var(--border-color)] p-4 hover:bg-[var(--hover-bg)] transition-colors cursor-pointer"
+                        onClick={() => router.push(`/posts/${reply.id}`)}>
+                        <div className="rounded-xl bg-[var(--hover-bg)] px-4 py-3 text-gray-500">
+                            This post was deleted
+                        </div>
+                    </div>
+                ) : (
                     <div key={reply.id} className="border-b border-[var(--border-color)] p-4 hover:bg-[var(--hover-bg)] transition-colors cursor-pointer"
                         onClick={() => router.push(`/posts/${reply.id}`)}>
                         <div className="flex space-x-3">
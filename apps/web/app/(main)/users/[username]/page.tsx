@@ -9,7 +9,6 @@ import Link from 'next/link';
 interface UserProfile {
     id: number;
     username: string;
-    email: string;
     created_at: string;
 }
 
@@ -1,4 +1,5 @@
-import { CreatePostRequest, PostResponse } from '../types/post';
+import { CreatePostRequest, PostPage, PostResponse } from '../types/post';
+import { UserResponse } from '../../users/types/user';
 
 const API_URL = 'http://localhost:8080/api';
 
@@ -20,8 +21,11 @@ export const createPost = async (data: CreatePostRequest): Promise<PostResponse>
     return response.json();
 };
 
-export const getTimeline = async (limit = 20, offset = 0, userId?: number): Promise<PostResponse[]> => {
-    let url = `${API_URL}/posts?limit=${limit}&offset=${offset}`;
+export const getTimeline = async (limit = 20, cursor?: string, userId?: number): Promise<PostPage> => {
+    let url = `${API_URL}/posts?limit=${limit}`;
+    if (cursor) {
+        url += `&cursor=${encodeURIComponent(cursor)}`;
+    }
     if (userId) {
         url += `&user_id=${userId}`;
     }
@@ -37,8 +41,12 @@ export const getTimeline = async (limit = 20, offset = 0, userId?: number): Prom
     return response.json();
 };
 
-export const getBookmarks = async (limit = 20, offset = 0): Promise<PostResponse[]> => {
-    const response = await fetch(`${API_URL}/bookmarks?limit=${limit}&offset=${offset}`, {
+export const getBookmarks = async (limit = 20, cursor?: string): Promise<PostPage> => {
+    let url = `${API_URL}/bookmarks?limit=${limit}`;
+    if (cursor) {
+        url += `&cursor=${encodeURIComponent(cursor)}`;
+    }
+    const response = await fetch(url, {
         credentials: 'include',
     });
 
@@ -50,6 +58,36 @@ export const getBookmarks = async (limit = 20, offset = 0): Promise<PostResponse
     return response.json();
 };
 
+export const getUserLikes = async (username: string, limit = 20, cursor?: string): Promise<PostPage> => {
+    let url = `${API_URL}/users/${encodeURIComponent(username)}/likes?limit=${limit}`;
+    if (cursor) {
+        url += `&cursor=${encodeURIComponent(cursor)}`;
+    }
+    const response = await fetch(url, {
+        credentials: 'include',
+    });
+
+    if (!response.ok) {
+        const errorData = await response.json();
+        throw new Error(errorData.error || 'Failed to fetch liked posts');
+    }
+
+    return response.json();
+};
+
+export const getPostLikers = async (postId: number, limit = 20, offset = 0): Promise<UserResponse[]> => {
+    const response = await fetch(`${API_URL}/posts/${postId}/likes?limit=${limit}&offset=${offset}`, {
+        credentials: 'include',
+    });
+
+    if (!response.ok) {
+        const errorData = await response.json();
+        throw new Error(errorData.error || 'Failed to fetch likes');
+    }
+
+    return response.json();
+};
+
 export const toggleLike = async (postId: number): Promise<{ is_liked: boolean; like_count: number }> => {
     const response = await fetch(`${API_URL}/posts/${postId}/like`, {
         method: 'POST',
@@ -78,6 +116,18 @@ export const toggleBookmark = async (postId: number): Promise<{ is_bookmarked: b
     return response.json();
 };
 
+export const undoRepost = async (postId: number): Promise<void> => {
+    const response = await fetch(`${API_URL}/posts/${postId}/repost`, {
+        method: 'DELETE',
+        credentials: 'include',
+    });
+
+    if (!response.ok) {
+        const errorData = await response.json().catch(() => ({ error: 'Failed to undo repost' }));
+        throw new Error(errorData.error || 'Failed to undo repost');
+    }
+};
+
 export const deletePost = async (postId: number): Promise<void> => {
     const response = await fetch(`${API_URL}/posts/${postId}`, {
         method: 'DELETE',
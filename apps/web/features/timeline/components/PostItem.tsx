@@ -19,8 +19,10 @@ export const PostItem = ({ post, onToggleLike, onToggleBookmark, onRepost, onDel
     const { user } = useAuth();
     const [openMenuId, setOpenMenuId] = useState<number | null>(null);
 
-    const displayPost = post.repost || post;
-    const isRepost = !!post.repost;
+    // Plain reposts show the original; quotes show themselves with the original embedded
+    const isRepost = post.kind === 'repost' && !!post.repost;
+    const displayPost = isRepost ? post.repost! : post;
+    const quoted = !isRepost ? post.repost : undefined;
 
     const handlePostClick = () => {
         router.push(`/posts/${displayPost.id}`);
@@ -34,6 +36,25 @@ export const PostItem = ({ post, onToggleLike, onToggleBookmark, onRepost, onDel
         setOpenMenuId(null);
     };
 
+    if (displayPost.is_deleted) {
+        return (
+            <div
+                onClick={handlePostClick}
+                className="border-b border-[var(--border-color)] p-4 hover:bg-[var(--hover-bg)] transition-colors cursor-pointer"
+            >
+                {isRepost && (
+                    <div className="text-gray-500 text-sm mb-1 ml-12">
+                        <span className="font-bold">{post.author.username}</span>
+                        <span className="ml-2">reposted</span>
+                    </div>
+                )}
+                <div className="rounded-xl bg-[var(--hover-bg)] px-4 py-3 text-gray-500">
+                    This post was deleted
+                </div>
+            </div>
+        );
+    }
+
     return (
         <div
             onClick={handlePostClick}
@@ -99,6 +120,25 @@ export const PostItem = ({ post, onToggleLike, onToggleBookmark, onRepost, onDel
                         {displayPost.content}
                     </div>
 
+                    {quoted && (
+                        <div
+                            onClick={(e) => { e.stopPropagation(); router.push(`/posts/${quoted.id}`); }}
+                            className="border border-[var(--border-color)] rounded-xl p-3 mb-3 hover:bg-[var(--hover-bg)] transition-colors"
+                        >
+                            {quoted.is_deleted ? (
+                                <span className="text-gray-500">This post was deleted</span>
+                            ) : (
+                                <>
+                                    <div className="flex items-center space-x-1 text-sm">
+                                        <span className="font-bold text-white">{quoted.author.username}</span>
+                                        <span className="text-gray-500">@{quoted.author.username}</span>
+                                    </div>
+                                    <div className="text-white whitespace-pre-wrap mt-1">{quoted.content}</div>
+                                </>
+                            )}
+                        </div>
+                    )}
+
                     <div className="flex justify-between max-w-md text-gray-500">
                         {/* Reply Button */}
                         <button
@@ -11,8 +11,8 @@ export const useBookmarks = () => {
         setIsLoading(true);
         setError(null);
         try {
-            const data = await getBookmarks(20, 0);
-            setPosts(data);
+            const data = await getBookmarks(20);
+            setPosts(data.posts);
         } catch (err: any) {
             setError(err.message);
         } finally {
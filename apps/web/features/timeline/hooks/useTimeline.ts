@@ -1,5 +1,5 @@
 import { useState, useEffect, useCallback } from 'react';
-import { getTimeline, createPost, toggleLike as apiToggleLike, toggleBookmark as apiToggleBookmark, deletePost as apiDeletePost } from '../api/timelineApi';
+import { getTimeline, createPost, toggleLike as apiToggleLike, toggleBookmark as apiToggleBookmark, deletePost as apiDeletePost, undoRepost } from '../api/timelineApi';
 import { Post } from '../types/post';
 
 export const useTimeline = (userId?: number) => {
@@ -11,8 +11,8 @@ export const useTimeline = (userId?: number) => {
         setIsLoading(true);
         setError(null);
         try {
-            const data = await getTimeline(20, 0, userId);
-            setPosts(data);
+            const data = await getTimeline(20, undefined, userId);
+            setPosts(data.posts);
         } catch (err: any) {
             setError(err.message);
         } finally {
@@ -50,6 +50,12 @@ export const useTimeline = (userId?: number) => {
     };
 
     const repostMessage = async (repostId: number) => {
+        const target = posts.find((post) => post.id === repostId)
+            ?? posts.find((post) => post.repost?.id === repostId)?.repost;
+        if (target?.is_reposted) {
+            return undoRepostMessage(repostId);
+        }
+
         setIsLoading(true);
         setError(null);
         try {
@@ -76,6 +82,24 @@ export const useTimeline = (userId?: number) => {
         }
     };
 
+    const undoRepostMessage = async (repostId: number) => {
+        try {
+            await undoRepost(repostId);
+            setPosts((prevPosts) => prevPosts.map((post) => {
+                if (post.id === repostId) {
+                    return { ...post, repost_count: Math.max((post.repost_count || 0) - 1, 0), is_reposted: false };
+                }
+                if (post.repost && post.repost.id === repostId) {
+                    return { ...post, repost: { ...post.repost, repost_count: Math.max((post.repost.repost_count || 0) - 1, 0), is_reposted: false } };
+                }
+                return post;
+            }));
+        } catch (err: any) {
+            setError(err.message);
+            throw err;
+        }
+    };
+
     const toggleLike = async (postId: number) => {
         try {
             const { is_liked, like_count } = await apiToggleLike(postId);
@@ -1,19 +1,56 @@
 import { UserResponse } from '../../users/types/user';
 
+export type Mention = {
+    user_id: number;
+    username: string;
+    start: number;
+    end: number;
+};
+
+export type MediaVariant = {
+    url: string;
+    width: number;
+    height: number;
+};
+
+export type Media = {
+    id: number;
+    url: string;
+    mime_type: string;
+    width: number;
+    height: number;
+    status: 'pending' | 'ready' | 'failed';
+    blurhash: string;
+    variants: MediaVariant[];
+    srcset: string;
+};
+
+export type ReplyPolicy = 'everyone' | 'following' | 'mentioned';
+
 export type Post = {
     id: number;
     content: string;
+    kind: 'original' | 'reply' | 'repost' | 'quote';
+    reply_policy: ReplyPolicy;
     author: UserResponse;
     parent_id?: number;
     repost_id?: number;
     repost?: Post;
+    mentions: Mention[];
+    media: Media[];
     like_count: number;
     is_liked: boolean;
     bookmark_count: number;
     is_bookmarked: boolean;
     reply_count: number;
     repost_count: number;
+    quote_count: number;
+    conversation_id: number;
     is_reposted: boolean;
+    can_reply: boolean;
+    revision_count: number;
+    edited_at: string | null;
+    is_deleted: boolean;
     created_at: string;
 };
 
@@ -21,6 +58,23 @@ export type CreatePostRequest = {
     content: string;
     parent_id?: number;
     repost_id?: number;
+    media_ids?: number[];
+    reply_policy?: ReplyPolicy;
 };
 
 export type PostResponse = Post;
+
+export type ConversationNode = Post & {
+    replies: ConversationNode[];
+};
+
+export type Conversation = {
+    ancestors: Post[];
+    post: Post;
+    replies: ConversationNode[];
+};
+
+export type PostPage = {
+    posts: Post[];
+    next_cursor: string | null;
+};
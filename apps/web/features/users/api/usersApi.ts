@@ -1,4 +1,4 @@
-import { CreateUserRequest, UserResponse } from '../types/user';
+import { CreateUserRequest, UserProfile, UserResponse } from '../types/user';
 
 const API_URL = 'http://localhost:8080/api';
 
@@ -20,7 +20,7 @@ export const createUser = async (data: CreateUserRequest): Promise<UserResponse>
     return response.json();
 };
 
-export const getUserProfile = async (username: string): Promise<UserResponse> => {
+export const getUserProfile = async (username: string): Promise<UserProfile> => {
     const response = await fetch(`${API_URL}/users/${username}`, {
         credentials: 'include',
     });
@@ -1,12 +1,43 @@
 export type User = {
   id: number;
   username: string;
-  email: string;
+  display_name: string;
   bio: string;
+  location: string;
+  website: string;
+  avatar_url: string;
+  banner_url: string;
+  birthday?: string;
+  is_private: boolean;
   created_at: string;
   updated_at: string;
 };
 
+export type UserProfile = User & {
+  follower_count: number;
+  following_count: number;
+  post_count: number;
+  is_followed_by_me: boolean;
+  follows_me: boolean;
+  is_requested: boolean;
+};
+
+export type BirthdayVisibility = "public" | "followers" | "private";
+
+export type Me = User & {
+  email: string;
+  birthday_visibility: BirthdayVisibility;
+};
+
+export type UpdateProfileRequest = {
+  display_name?: string;
+  bio?: string;
+  location?: string;
+  website?: string;
+  birthday?: string;
+  birthday_visibility?: BirthdayVisibility;
+};
+
 export type CreateUserRequest = {
   username: string;
   email: string;